@@ -0,0 +1,9 @@
+# Restrict the lookup to a project if the name is used by the actions of several projects
+data "aria_abx_action" "example" {
+  name       = "Send Notification"
+  project_id = data.aria_project.example.id
+}
+
+data "aria_project" "example" {
+  name = "Development"
+}
@@ -0,0 +1,8 @@
+data "aria_abx_actions" "example" {
+  filter = "runtime eq 'python'"
+  search = "hello"
+}
+
+output "abx_action_ids" {
+  value = data.aria_abx_actions.example.actions[*].id
+}
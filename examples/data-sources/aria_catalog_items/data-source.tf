@@ -0,0 +1,7 @@
+data "aria_catalog_items" "blueprints" {
+  search = "ubuntu"
+}
+
+output "catalog_item_ids" {
+  value = { for item in data.aria_catalog_items.blueprints.items : item.name => item.id }
+}
@@ -0,0 +1,7 @@
+data "aria_cloud_account_vsphere" "example" {
+  name = "vCenter Geneva"
+}
+
+output "region_ids" {
+  value = data.aria_cloud_account_vsphere.example.region_ids
+}
@@ -0,0 +1,7 @@
+data "aria_cloud_zone" "example" {
+  name = "Geneva Compute"
+}
+
+output "cloud_zone_id" {
+  value = data.aria_cloud_zone.example.id
+}
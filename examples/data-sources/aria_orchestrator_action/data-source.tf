@@ -0,0 +1,8 @@
+data "aria_orchestrator_action" "by_fqn" {
+  fqn = "com.vmware.library.vcaccafe.util/getVRAHost"
+}
+
+data "aria_orchestrator_action" "by_module_and_name" {
+  module = "com.vmware.library.vcaccafe.util"
+  name   = "getVRAHost"
+}
@@ -0,0 +1,10 @@
+data "aria_orchestrator_category" "example" {
+  path = "Library/Mail"
+  type = "WorkflowCategory"
+}
+
+resource "aria_orchestrator_category" "child" {
+  name      = "Notifications"
+  type      = "WorkflowCategory"
+  parent_id = data.aria_orchestrator_category.example.id
+}
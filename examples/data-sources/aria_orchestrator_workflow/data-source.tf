@@ -0,0 +1,9 @@
+data "aria_orchestrator_category" "mail" {
+  path = "Library/Mail"
+  type = "WorkflowCategory"
+}
+
+data "aria_orchestrator_workflow" "example" {
+  name        = "Send notification"
+  category_id = data.aria_orchestrator_category.mail.id
+}
@@ -0,0 +1,8 @@
+# Conditions are the ones of vRO API (name~ for contains, name= for equals)
+data "aria_orchestrator_workflows" "example" {
+  filter = "name~Send Mail"
+}
+
+output "workflow_ids" {
+  value = { for workflow in data.aria_orchestrator_workflows.example.workflows : workflow.name => workflow.id }
+}
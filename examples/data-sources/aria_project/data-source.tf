@@ -0,0 +1,7 @@
+data "aria_project" "example" {
+  name = "Development"
+}
+
+output "project_id" {
+  value = data.aria_project.example.id
+}
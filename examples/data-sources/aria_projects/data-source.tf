@@ -0,0 +1,9 @@
+data "aria_projects" "all" {}
+
+data "aria_projects" "example" {
+  filter = "name eq 'Development'"
+}
+
+output "project_ids" {
+  value = { for project in data.aria_projects.all.projects : project.name => project.id }
+}
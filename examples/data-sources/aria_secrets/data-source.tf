@@ -0,0 +1,7 @@
+data "aria_secrets" "example" {
+  search = "vault"
+}
+
+output "secret_ids" {
+  value = { for secret in data.aria_secrets.example.secrets : secret.name => secret.id }
+}
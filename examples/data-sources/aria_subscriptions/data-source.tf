@@ -0,0 +1,7 @@
+data "aria_subscriptions" "example" {
+  filter = "eventTopicId eq 'compute.provision.post'"
+}
+
+output "subscription_ids" {
+  value = data.aria_subscriptions.example.subscriptions[*].id
+}
@@ -0,0 +1,7 @@
+data "aria_tags" "environments" {
+  filter = "key eq 'environment'"
+}
+
+output "environments" {
+  value = data.aria_tags.environments.tags[*].value
+}
@@ -0,0 +1,9 @@
+# Expose an ABX constant to an action (inputs key secret:<id>)
+output "constant_input_key" {
+  value = provider::aria::abx_input_key("constant", aria_abx_constant.example.id)
+}
+
+# Expose a secret to an action (inputs key psecret:<id>)
+output "secret_input_key" {
+  value = provider::aria::abx_input_key("secret", data.aria_secret.example.id)
+}
@@ -0,0 +1,30 @@
+# Render the content sent to the API by the aria_cloud_template_v1 resource
+output "cloud_template_content" {
+  value = provider::aria::cloud_template_yaml(
+    {
+      flavor = {
+        title              = "Flavor"
+        description        = "Size of the VM"
+        type               = "string"
+        default            = jsonencode("small")
+        encrypted          = false
+        read_only          = false
+        recreate_on_update = false
+        one_of = [
+          { const = "small", title = "Small", encrypted = false },
+          { const = "large", title = "Large", encrypted = false },
+        ]
+      }
+    },
+    {
+      vm = {
+        type                  = "Cloud.vSphere.Machine"
+        allocate_per_instance = true
+        properties = jsonencode({
+          image  = "ubuntu"
+          flavor = "$${input.flavor}"
+        })
+      }
+    }
+  )
+}
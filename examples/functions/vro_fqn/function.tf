@@ -0,0 +1,3 @@
+output "action_fqn" {
+  value = provider::aria::vro_fqn("ch.ocsin.core", "getVRAHost") # ch.ocsin.core/getVRAHost
+}
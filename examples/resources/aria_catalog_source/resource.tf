@@ -13,7 +13,7 @@ resource "aria_catalog_source" "library_project_cloud_templates" {
   name        = "Cloud Templates Catalog Source"
   description = "Publish some Cloud templates from a library project."
   project_id  = var.library_project_id
-  type_id     = "com.vmw.abx.actions"
+  type_id     = "com.vmw.blueprint"
 
   config = {
     source_project_id = var.library_project_id
@@ -0,0 +1,3 @@
+# Cloud account can be imported by specifying the instance's unique identifier.
+# The password is not returned by the API, it will be set by the next apply.
+terraform import aria_cloud_account_vsphere.vcenter 9a7c4d52-1b3e-4f6a-8d2c-5e1f0b7a3c9d
@@ -0,0 +1,27 @@
+variable "vcenter_password" {
+  description = "Password of the vCenter service account."
+  type        = string
+  sensitive   = true
+}
+
+resource "aria_cloud_account_vsphere" "vcenter" {
+  name                           = "vCenter Geneva"
+  description                    = "Main vCenter of the datacenter."
+  hostname                       = "vcenter.example.org"
+  username                       = "svc-aria@vsphere.local"
+  password                       = var.vcenter_password
+  accept_self_signed_certificate = false
+
+  regions = [
+    {
+      external_region_id = "Datacenter:datacenter-3"
+      name               = "Datacenter"
+    }
+  ]
+
+  tags = [{ key = "site", value = "geneva" }]
+}
+
+output "region_id" {
+  value = aria_cloud_account_vsphere.vcenter.region_ids["Datacenter:datacenter-3"]
+}
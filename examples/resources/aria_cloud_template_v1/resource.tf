@@ -0,0 +1,62 @@
+resource "aria_cloud_template_v1" "linux_vm" {
+  name              = "Linux VM"
+  description       = "Deploy a Linux VM attached to an existing network."
+  project_id        = aria_project.example.id
+  request_scope_org = false
+
+  inputs = {
+    flavor = {
+      name               = "flavor"
+      title              = "Flavor"
+      description        = "Size of the VM"
+      type               = "string"
+      default            = jsonencode("small")
+      encrypted          = false
+      read_only          = false
+      recreate_on_update = false
+      one_of = [
+        { const = "small", title = "Small", encrypted = false },
+        { const = "large", title = "Large", encrypted = false },
+      ]
+    }
+  }
+
+  resources = {
+    network = {
+      name = "network"
+      type = "Cloud.vSphere.Network"
+      metadata = {
+        layout_position = [0, 0]
+      }
+      properties = jsonencode({
+        networkType = "existing"
+        constraints = [{ tag = "env:production" }]
+      })
+    }
+    vm = {
+      name       = "vm"
+      type       = "Cloud.vSphere.Machine"
+      depends_on = ["network"]
+      metadata = {
+        layout_position = [0, 1]
+      }
+      # Expressions are escaped ($${...}) to be sent as is to Aria
+      properties = jsonencode({
+        image  = "ubuntu"
+        flavor = "$${input.flavor}"
+        networks = [
+          { network = "$${resource.network.id}" }
+        ]
+      })
+    }
+  }
+}
+
+# The content may also be declared as is (e.g. an existing blueprint)
+resource "aria_cloud_template_v1" "from_file" {
+  name              = "Linux VM (raw)"
+  description       = "Deploy a Linux VM from an hand-written blueprint."
+  project_id        = aria_project.example.id
+  request_scope_org = false
+  content           = file("${path.module}/blueprints/linux-vm.yaml")
+}
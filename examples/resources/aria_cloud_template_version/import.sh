@@ -0,0 +1,3 @@
+# Cloud template version can be imported by specifying the cloud template's unique identifier and
+# the version, separated by a slash.
+terraform import aria_cloud_template_version.linux_vm 3b5d7f9a-2c4e-4f6a-8b0c-1d3e5f7a9b2c/1.2.0
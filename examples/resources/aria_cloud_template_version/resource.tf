@@ -0,0 +1,31 @@
+resource "aria_cloud_template_v1" "linux_vm" {
+  name              = "Linux VM"
+  description       = "Deploy a Linux VM."
+  project_id        = var.library_project_id
+  request_scope_org = false
+  content           = file("${path.module}/blueprints/linux-vm.yaml")
+}
+
+# Release a version of the cloud template to the catalog
+resource "aria_cloud_template_version" "linux_vm" {
+  cloud_template_id = aria_cloud_template_v1.linux_vm.id
+  version           = "1.2.0"
+  description       = "Larger flavors."
+  change_log        = "Add the large flavor."
+  release           = true
+}
+
+# Import the released versions of the cloud templates of the project into the catalog
+resource "aria_catalog_source" "library_project_cloud_templates" {
+  name        = "Cloud Templates Catalog Source"
+  description = "Publish the released Cloud templates of a library project."
+  project_id  = var.library_project_id
+  type_id     = "com.vmw.blueprint"
+
+  config = {
+    source_project_id = var.library_project_id
+  }
+
+  # Refreshed every time a new version is released
+  import_trigger = aria_cloud_template_version.linux_vm.id
+}
@@ -0,0 +1,2 @@
+# Cloud zone can be imported by specifying the instance's unique identifier.
+terraform import aria_cloud_zone.compute 3c5ec8a6-1a8e-4c4c-9d4f-9a1f0c2d8e7b
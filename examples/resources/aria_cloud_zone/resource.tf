@@ -0,0 +1,14 @@
+resource "aria_cloud_zone" "compute" {
+  name             = "Geneva Compute"
+  description      = "Compute clusters of the datacenter."
+  region_id        = aria_cloud_account_vsphere.vcenter.region_ids["Datacenter:datacenter-3"]
+  placement_policy = "SPREAD"
+  folder           = "Aria/Deployments"
+
+  tags          = [{ key = "site", value = "geneva" }]
+  tags_to_match = [{ key = "cluster", value = "compute" }]
+
+  custom_properties = {
+    environment = "production"
+  }
+}
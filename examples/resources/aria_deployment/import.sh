@@ -0,0 +1,3 @@
+# Deployment can be imported by specifying the unique identifier, its inputs are not imported
+# (declare them to manage them).
+terraform import aria_deployment.linux_vm_smoke_test 5e2a8c4f-9b1d-4e7a-b3c6-2d8f0a4e6b19
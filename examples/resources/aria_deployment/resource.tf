@@ -0,0 +1,35 @@
+# Smoke test a catalog item (e.g. released from a cloud template)
+resource "aria_deployment" "linux_vm_smoke_test" {
+  name                 = "Linux VM smoke test"
+  description          = "Smoke test of the Linux VM catalog item."
+  project_id           = var.test_project_id
+  catalog_item_id      = var.linux_vm_catalog_item_id
+  catalog_item_version = "1.2.0"
+  reason               = "Smoke testing the catalog"
+
+  # Changing the inputs triggers a day-2 update of the deployment
+  inputs = jsonencode({
+    flavor = "small"
+  })
+
+  timeouts {
+    create = "30m"
+    update = "30m"
+    delete = "30m"
+  }
+}
+
+# Or deploy the draft of a cloud template
+resource "aria_deployment" "linux_vm_draft" {
+  name              = "Linux VM draft"
+  project_id        = var.test_project_id
+  cloud_template_id = aria_cloud_template_v1.linux_vm.id
+
+  inputs = jsonencode({
+    flavor = "large"
+  })
+}
+
+output "linux_vm_address" {
+  value = jsondecode(aria_deployment.linux_vm_smoke_test.outputs).address.value
+}
@@ -0,0 +1,2 @@
+# Flavor profile can be imported by specifying the instance's unique identifier.
+terraform import aria_flavor_profile.geneva 5b1f8d3e-2c47-4a9e-b6d0-7e3a9c1f4b28
@@ -0,0 +1,20 @@
+resource "aria_flavor_profile" "geneva" {
+  name        = "Geneva Flavors"
+  description = "Flavors of the Geneva datacenter."
+  region_id   = aria_cloud_account_vsphere.vcenter.region_ids["Datacenter:datacenter-3"]
+
+  flavor_mapping = {
+    small = {
+      cpu_count    = 1
+      memory_in_mb = 2048
+    }
+    medium = {
+      cpu_count    = 2
+      memory_in_mb = 4096
+    }
+    large = {
+      cpu_count    = 4
+      memory_in_mb = 16384
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# Image profile can be imported by specifying the instance's unique identifier.
+terraform import aria_image_profile.geneva c8e2a7f1-4d90-4b3c-a5e6-1f7b2d9c0a34
@@ -0,0 +1,18 @@
+resource "aria_image_profile" "geneva" {
+  name        = "Geneva Images"
+  description = "Images of the Geneva datacenter."
+  region_id   = aria_cloud_account_vsphere.vcenter.region_ids["Datacenter:datacenter-3"]
+
+  image_mapping = {
+    ubuntu = {
+      image_name   = "ubuntu-24.04-template"
+      cloud_config = <<-EOT
+        #cloud-config
+        package_update: true
+      EOT
+    }
+    windows = {
+      image_name = "windows-2022-template"
+    }
+  }
+}
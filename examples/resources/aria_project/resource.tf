@@ -0,0 +1,46 @@
+variable "zone_id" {
+  description = "Cloud zone to assign to the project."
+  type        = string
+}
+
+resource "aria_project" "example" {
+  name              = "Example"
+  operation_timeout = 3600
+  shared_resources  = true
+
+  memberships = [
+    {
+      email = "ops@example.org"
+      type  = "group"
+      role  = "administrator"
+    },
+    {
+      email = "jane.doe@example.org"
+      type  = "user"
+      role  = "member"
+    }
+  ]
+
+  zones = [
+    {
+      zone_id         = var.zone_id
+      priority        = 1
+      max_instances   = 20
+      cpu_limit       = 40
+      memory_limit_mb = 163840
+    }
+  ]
+
+  placement_policy = "SPREAD"
+
+  constraints = {
+    network = [{ expression = "env:production" }]
+    storage = [{ expression = "tier:gold", mandatory = false }]
+  }
+
+  machine_naming_template = "example-$${####}"
+
+  properties = {
+    owner = "ops"
+  }
+}
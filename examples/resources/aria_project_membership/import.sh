@@ -0,0 +1,3 @@
+# Project membership can be imported by specifying the project's unique identifier, the type and
+# the email of the principal, separated by slashes.
+terraform import aria_project_membership.developers 2e34b115-dd18-48b3-a6af-f794469e5e0d/group/developers@example.org
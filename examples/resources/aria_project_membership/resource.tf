@@ -0,0 +1,11 @@
+# Grant the developers the member role on a project managed elsewhere
+resource "aria_project_membership" "developers" {
+  project_id = data.aria_project.example.id
+  email      = "developers@example.org"
+  type       = "group"
+  role       = "member"
+}
+
+data "aria_project" "example" {
+  name = "Example"
+}
@@ -0,0 +1,34 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+import (
+	"net/http"
+	"time"
+)
+
+func (self *Server) RegisterABX() {
+	self.RegisterCollection(Collection{
+		Path:         "abx/api/resources/actions",
+		CreateStatus: http.StatusOK,
+		OnSave: func(server *Server, item map[string]any) {
+			SetDefault(item, "orgId", server.OrgId)
+			SetDefault(item, "provider", "")
+			SetDefault(item, "system", false)
+			SetDefault(item, "asyncDeployed", false)
+		},
+	})
+
+	self.RegisterCollection(Collection{
+		Path:         "abx/api/resources/action-secrets",
+		CreateStatus: http.StatusOK,
+		OnSave: func(server *Server, item map[string]any) {
+			SetDefault(item, "orgId", server.OrgId)
+			SetDefault(item, "createdMillis", time.Now().UnixMilli())
+			if encrypted, _ := item["encrypted"].(bool); encrypted {
+				item["value"] = "*****"
+			}
+		},
+	})
+}
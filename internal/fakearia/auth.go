@@ -0,0 +1,73 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Token accepted by the login endpoint.
+const REFRESH_TOKEN = "fake-aria-refresh-token"
+
+// Name of the user owning the token.
+const USERNAME = "fake-aria-user"
+
+// Paths of the login endpoints (these are not authenticated).
+var AUTH_PATHS = []string{
+	"/iaas/api/login",
+	"/csp/gateway/am/api/login",
+	"/csp/gateway/am/api/auth/authorize",
+}
+
+func (self *Server) RegisterAuth() {
+	self.Handle("POST iaas/api/login", func(w http.ResponseWriter, r *http.Request) {
+		body, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		if body["refreshToken"] != REFRESH_TOKEN {
+			self.WriteError(w, http.StatusBadRequest, "Invalid refresh token")
+			return
+		}
+		self.WriteJSON(w, http.StatusOK, map[string]any{
+			"tokenType": "Bearer",
+			"token":     self.NewAccessToken(),
+		})
+	})
+
+	self.Handle("POST csp/gateway/am/api/login", func(w http.ResponseWriter, r *http.Request) {
+		self.WriteJSON(w, http.StatusOK, map[string]any{"refresh_token": REFRESH_TOKEN})
+	})
+
+	self.Handle(
+		"POST csp/gateway/am/api/auth/authorize",
+		func(w http.ResponseWriter, r *http.Request) {
+			self.WriteJSON(w, http.StatusOK, map[string]any{
+				"token_type":   "bearer",
+				"access_token": self.NewAccessToken(),
+				"expires_in":   1800,
+			})
+		})
+}
+
+// Generate and register a new access token.
+func (self *Server) NewAccessToken() string {
+	token := uuid.NewString()
+	self.tokens[token] = true
+	return token
+}
+
+// Return true if the request is made with an access token delivered by the server.
+func (self *Server) IsAuthenticated(r *http.Request) bool {
+	for _, path := range AUTH_PATHS {
+		if r.URL.Path == path {
+			return true
+		}
+	}
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return found && self.tokens[token]
+}
@@ -0,0 +1,213 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v2"
+)
+
+func (self *Server) RegisterBlueprint() {
+	self.RegisterCollection(Collection{
+		Path: "blueprint/api/blueprints",
+		OnSave: func(server *Server, item map[string]any) {
+			SetDefault(item, "orgId", server.OrgId)
+			SetDefault(item, "status", "DRAFT")
+			content, _ := item["content"].(string)
+			messages := ValidateBlueprint(content)
+			item["valid"] = len(messages) == 0
+			item["validationMessages"] = messages
+		},
+	})
+	self.RegisterBlueprintVersions()
+
+	// Validate a content (e.g. before creating or updating the blueprint)
+	self.Handle(
+		"POST blueprint/api/blueprint-validation",
+		func(w http.ResponseWriter, r *http.Request) {
+			body, ok := self.ReadBody(w, r)
+			if !ok {
+				return
+			}
+			content, _ := body["content"].(string)
+			messages := ValidateBlueprint(content)
+			self.WriteJSON(w, http.StatusOK, map[string]any{
+				"valid":              len(messages) == 0,
+				"validationMessages": messages,
+			})
+		})
+}
+
+// Store of the versions of the blueprints, by blueprint identifier and version.
+const BLUEPRINT_VERSIONS = "blueprint/api/blueprints/versions"
+
+func (self *Server) RegisterBlueprintVersions() {
+	path := "blueprint/api/blueprints/{id}/versions"
+
+	// Version the blueprint (a snapshot of its content) and release it if requested
+	self.Handle("POST "+path, func(w http.ResponseWriter, r *http.Request) {
+		blueprint := self.GetOr404(w, "blueprint/api/blueprints", r.PathValue("id"))
+		if blueprint == nil {
+			return
+		}
+		body, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		version, _ := body["version"].(string)
+		versionId := r.PathValue("id") + "/" + version
+		if len(version) == 0 {
+			self.WriteError(w, http.StatusBadRequest, "version is required")
+			return
+		}
+		if self.Get(BLUEPRINT_VERSIONS, versionId) != nil {
+			self.WriteError(w, http.StatusConflict, fmt.Sprintf(
+				"Version %s already exists", version))
+			return
+		}
+		if valid, _ := blueprint["valid"].(bool); !valid {
+			self.WriteError(w, http.StatusBadRequest, "Cannot version an invalid blueprint")
+			return
+		}
+
+		status := "VERSIONED"
+		if release, _ := body["release"].(bool); release {
+			status = "RELEASED"
+		}
+		item := map[string]any{
+			"id":                 uuid.NewString(),
+			"blueprintId":        blueprint["id"],
+			"name":               blueprint["name"],
+			"version":            version,
+			"versionDescription": body["description"],
+			"versionChangeLog":   body["changeLog"],
+			"status":             status,
+			"content":            blueprint["content"],
+			"valid":              blueprint["valid"],
+			"createdAt":          Now(),
+			"createdBy":          USERNAME,
+			"projectId":          blueprint["projectId"],
+			"orgId":              blueprint["orgId"],
+		}
+		self.Put(BLUEPRINT_VERSIONS, versionId, item)
+		self.WriteJSON(w, http.StatusCreated, item)
+	})
+
+	self.Handle("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		if self.GetOr404(w, "blueprint/api/blueprints", r.PathValue("id")) != nil {
+			self.WritePage(w, r, self.BlueprintVersions(r.PathValue("id")))
+		}
+	})
+
+	self.Handle("GET "+path+"/{version}", func(w http.ResponseWriter, r *http.Request) {
+		if item := self.GetBlueprintVersionOr404(w, r); item != nil {
+			self.WriteJSON(w, http.StatusOK, item)
+		}
+	})
+
+	for action, status := range map[string]string{"release": "RELEASED", "unrelease": "VERSIONED"} {
+		self.Handle(
+			"POST "+path+"/{version}/actions/"+action,
+			func(w http.ResponseWriter, r *http.Request) {
+				if item := self.GetBlueprintVersionOr404(w, r); item != nil {
+					item["status"] = status
+					self.WriteJSON(w, http.StatusOK, item)
+				}
+			})
+	}
+}
+
+// Return the version of the blueprint (both given in the path), write a not found error and
+// return nil if any of them is not found (versions are deleted along with their blueprint).
+func (self *Server) GetBlueprintVersionOr404(
+	w http.ResponseWriter,
+	r *http.Request,
+) map[string]any {
+	if self.GetOr404(w, "blueprint/api/blueprints", r.PathValue("id")) == nil {
+		return nil
+	}
+	return self.GetOr404(w, BLUEPRINT_VERSIONS, r.PathValue("id")+"/"+r.PathValue("version"))
+}
+
+// Return the versions of a blueprint.
+func (self *Server) BlueprintVersions(blueprintId string) []map[string]any {
+	versions := []map[string]any{}
+	for _, version := range self.List(BLUEPRINT_VERSIONS) {
+		if version["blueprintId"] == blueprintId {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// Return true if any version of the blueprint is released.
+func (self *Server) IsBlueprintReleased(blueprintId string) bool {
+	for _, version := range self.BlueprintVersions(blueprintId) {
+		if version["status"] == "RELEASED" {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate the content of a blueprint, a (very) small subset of the checks made by the platform.
+func ValidateBlueprint(content string) []map[string]any {
+	var blueprint struct {
+		Inputs    map[string]map[string]any `yaml:"inputs"`
+		Resources map[string]map[string]any `yaml:"resources"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &blueprint); err != nil {
+		return []map[string]any{
+			{"path": "$", "message": "Invalid YAML: " + err.Error(), "type": "ERROR"},
+		}
+	}
+
+	messages := []map[string]any{}
+	for _, name := range slices.Sorted(maps.Keys(blueprint.Inputs)) {
+		input := blueprint.Inputs[name]
+		values, hasEnum := input["enum"].([]any)
+		value, hasDefault := input["default"]
+		if hasEnum && hasDefault && !slices.Contains(values, value) {
+			messages = append(messages, map[string]any{
+				"path":    fmt.Sprintf("$.inputs.%s.default", name),
+				"message": fmt.Sprintf("Default value %v is not one of %v", value, values),
+				"type":    "ERROR",
+			})
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(blueprint.Resources)) {
+		if _, found := blueprint.Resources[name]["properties"]; !found {
+			messages = append(messages, map[string]any{
+				"resourceName": name,
+				"path":         fmt.Sprintf("$.resources.%s", name),
+				"message":      "Resource properties is mandatory",
+				"type":         "ERROR",
+			})
+		}
+	}
+	return messages
+}
+
+// Validate the inputs of a deployment of the blueprint, return the error (empty if valid).
+func ValidateInputs(content string, inputs map[string]any) string {
+	var blueprint struct {
+		Inputs map[string]map[string]any `yaml:"inputs"`
+	}
+	_ = yaml.Unmarshal([]byte(content), &blueprint)
+	for _, name := range slices.Sorted(maps.Keys(blueprint.Inputs)) {
+		values, hasEnum := blueprint.Inputs[name]["enum"].([]any)
+		value, found := inputs[name]
+		if hasEnum && found && !slices.ContainsFunc(values, func(allowed any) bool {
+			return fmt.Sprint(allowed) == fmt.Sprint(value)
+		}) {
+			return fmt.Sprintf("Input %s value %v is not one of %v", name, value, values)
+		}
+	}
+	return ""
+}
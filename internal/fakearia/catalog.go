@@ -0,0 +1,193 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Identifier of the embedded orchestrator's endpoint (integration).
+var VRO_ENDPOINT_ID = uuid.NewString()
+
+// Catalog types (by identifier).
+var CATALOG_TYPES = map[string]map[string]any{
+	"com.vmw.abx.actions": {
+		"id":      "com.vmw.abx.actions",
+		"name":    "Extensibility actions",
+		"baseUri": "http://abx-service.prelude.svc.cluster.local/abx/api/catalog",
+	},
+	"com.vmw.blueprint": {
+		"id":      "com.vmw.blueprint",
+		"name":    "VMware Aria Automation Templates",
+		"baseUri": "http://blueprints-service.prelude.svc.cluster.local/blueprint/api/catalog",
+	},
+	"com.vmw.vro.workflow": {
+		"id":      "com.vmw.vro.workflow",
+		"name":    "Automation Orchestrator Workflow",
+		"baseUri": "http://vco-service.prelude.svc.cluster.local/vco/api/catalog",
+	},
+}
+
+func (self *Server) RegisterCatalog() {
+	self.RegisterCatalogSources()
+	self.RegisterCatalogItems()
+	self.RegisterCatalogTypes()
+}
+
+func (self *Server) RegisterCatalogSources() {
+	self.RegisterCollection(Collection{
+		Path:   "catalog/api/admin/sources",
+		Upsert: true, // Sources are updated by calling POST on the collection
+		OnSave: func(server *Server, item map[string]any) {
+			// Creation is kept when the source is updated (replaced)
+			id, _ := item["id"].(string)
+			if existing := server.Get("catalog/api/admin/sources", id); existing != nil {
+				item["createdAt"] = existing["createdAt"]
+				item["createdBy"] = existing["createdBy"]
+			}
+
+			now := Now()
+			SetDefault(item, "createdAt", now)
+			SetDefault(item, "createdBy", USERNAME)
+			item["lastUpdatedAt"] = now
+			item["lastUpdatedBy"] = USERNAME
+
+			// Import is started, and completed when the source is retrieved
+			item["lastImportStartedAt"] = now
+			item["lastImportErrors"] = []any{}
+			item["itemsImported"] = 0
+			item["itemsFound"] = 0
+			delete(item, "lastImportCompletedAt")
+
+			// Source is global (shared) if not restricted to a project
+			config, _ := item["config"].(map[string]any)
+			projectId, _ := config["sourceProjectId"].(string)
+			item["global"] = len(projectId) == 0
+		},
+		OnRead: func(server *Server, item map[string]any) {
+			if _, completed := item["lastImportCompletedAt"]; !completed {
+				count := server.CatalogSourceItemsCount(item)
+				item["lastImportCompletedAt"] = Now()
+				item["itemsImported"] = count
+				item["itemsFound"] = count
+			}
+		},
+	})
+}
+
+// Return the number of items imported by a catalog source, the workflows or the blueprints of the
+// project having a released version.
+func (self *Server) CatalogSourceItemsCount(source map[string]any) int {
+	config, _ := source["config"].(map[string]any)
+	if source["typeId"] != "com.vmw.blueprint" {
+		workflows, _ := config["workflows"].([]any)
+		return len(workflows)
+	}
+	count := 0
+	for _, blueprint := range self.List("blueprint/api/blueprints") {
+		blueprintId, _ := blueprint["id"].(string)
+		if blueprint["projectId"] == config["sourceProjectId"] &&
+			self.IsBlueprintReleased(blueprintId) {
+			count++
+		}
+	}
+	return count
+}
+
+func (self *Server) RegisterCatalogItems() {
+	path := "catalog/api/admin/items"
+
+	self.Handle("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		search := strings.ToLower(r.URL.Query().Get("search"))
+		types := r.URL.Query().Get("types")
+		items := []map[string]any{}
+		for _, item := range self.List(path) {
+			name, _ := item["name"].(string)
+			itemType, _ := item["type"].(map[string]any)
+			if len(search) > 0 && !strings.Contains(strings.ToLower(name), search) {
+				continue
+			}
+			if len(types) > 0 && !strings.Contains(","+types+",", fmt.Sprintf(",%s,", itemType["id"])) {
+				continue
+			}
+			items = append(items, item)
+		}
+		self.WritePage(w, r, items)
+	})
+
+	self.Handle("GET "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if item := self.GetOr404(w, path, r.PathValue("id")); item != nil {
+			self.WriteJSON(w, http.StatusOK, item)
+		}
+	})
+
+	// Only the icon can be changed
+	self.Handle("PATCH "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		item := self.GetOr404(w, path, r.PathValue("id"))
+		if item == nil {
+			return
+		}
+		body, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		if iconId, found := body["iconId"]; found {
+			item["iconId"] = iconId
+			item["lastUpdatedAt"] = Now()
+			item["lastUpdatedBy"] = USERNAME
+		}
+		self.WriteJSON(w, http.StatusOK, item)
+	})
+}
+
+func (self *Server) RegisterCatalogTypes() {
+	self.Handle("GET catalog/api/types/{id}", func(w http.ResponseWriter, r *http.Request) {
+		catalogType, found := CATALOG_TYPES[r.PathValue("id")]
+		if !found {
+			self.WriteError(w, http.StatusNotFound, r.PathValue("id")+" not found")
+			return
+		}
+		self.WriteJSON(w, http.StatusOK, map[string]any{
+			"id":        catalogType["id"],
+			"name":      catalogType["name"],
+			"baseUri":   catalogType["baseUri"],
+			"createdAt": "2024-01-01T00:00:00Z",
+			"createdBy": "system-user",
+			"iconId":    self.Seeds.IconId,
+		})
+	})
+
+	self.Handle(
+		"GET catalog/api/types/com.vmw.vro.workflow/data/workflows",
+		func(w http.ResponseWriter, r *http.Request) {
+			items := []map[string]any{}
+			for _, workflow := range self.List("vco/api/workflows") {
+				items = append(items, map[string]any{
+					"id":          workflow["id"],
+					"name":        workflow["name"],
+					"description": workflow["description"],
+					"version":     workflow["version"],
+					"integration": self.VROIntegration(r),
+				})
+			}
+			if len(items) == 0 {
+				// Integration is returned alongside workflows, at least one is required
+				items = append(items, map[string]any{"integration": self.VROIntegration(r)})
+			}
+			self.WriteJSON(w, http.StatusOK, self.Page(items))
+		})
+}
+
+// Return the embedded orchestrator integration.
+func (self *Server) VROIntegration(r *http.Request) map[string]any {
+	return map[string]any{
+		"name":                      "embedded-VRO",
+		"endpointConfigurationLink": "/resources/endpoints/" + VRO_ENDPOINT_ID,
+		"endpointUri":               "https://" + r.Host + "/vco",
+	}
+}
@@ -0,0 +1,366 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v2"
+)
+
+// Store of the deployments, by identifier.
+const DEPLOYMENTS = "deployment/api/deployments"
+
+// Store of the requests made on the deployments, by identifier.
+const DEPLOYMENT_REQUESTS = "deployment/api/requests"
+
+func (self *Server) RegisterDeployment() {
+	// Request a blueprint (its current content or a version of it)
+	self.Handle(
+		"POST blueprint/api/blueprint-requests",
+		func(w http.ResponseWriter, r *http.Request) {
+			body, ok := self.ReadBody(w, r)
+			if !ok {
+				return
+			}
+			blueprintId, _ := body["blueprintId"].(string)
+			blueprint := self.Get("blueprint/api/blueprints", blueprintId)
+			if blueprint == nil {
+				self.WriteError(w, http.StatusBadRequest, fmt.Sprintf(
+					"Blueprint %s not found", blueprintId))
+				return
+			}
+			content := blueprint["content"]
+			if version, _ := body["blueprintVersion"].(string); len(version) > 0 {
+				item := self.Get(BLUEPRINT_VERSIONS, blueprintId+"/"+version)
+				if item == nil {
+					self.WriteError(w, http.StatusBadRequest, fmt.Sprintf(
+						"Version %s of blueprint %s not found", version, blueprintId))
+					return
+				}
+				content = item["content"]
+			}
+			contentString, _ := content.(string)
+			deployment := self.NewDeployment(body, contentString)
+			deployment["blueprintId"] = blueprintId
+			deployment["blueprintVersion"] = body["blueprintVersion"]
+			request, _ := deployment["lastRequest"].(map[string]any)
+			self.WriteJSON(w, http.StatusAccepted, map[string]any{
+				"id":           request["id"],
+				"deploymentId": deployment["id"],
+				"status":       "STARTED",
+			})
+		})
+
+	// Request a catalog item (the item is not backed by a blueprint, the deployment is empty)
+	self.Handle(
+		"POST catalog/api/items/{id}/request",
+		func(w http.ResponseWriter, r *http.Request) {
+			item := self.GetOr404(w, "catalog/api/admin/items", r.PathValue("id"))
+			if item == nil {
+				return
+			}
+			body, ok := self.ReadBody(w, r)
+			if !ok {
+				return
+			}
+			deployment := self.NewDeployment(body, "")
+			deployment["catalogItemId"] = item["id"]
+			deployment["catalogItemVersion"] = body["version"]
+			self.WriteJSON(w, http.StatusOK, []any{map[string]any{
+				"deploymentId":   deployment["id"],
+				"deploymentName": deployment["name"],
+			}})
+		})
+
+	self.Handle("GET "+DEPLOYMENTS+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		deployment := self.GetOr404(w, DEPLOYMENTS, r.PathValue("id"))
+		if deployment == nil {
+			return
+		}
+
+		// New deployments are not visible right away (the first retrieval is failing)
+		if visible, _ := deployment["visible"].(bool); !visible {
+			deployment["visible"] = true
+			self.WriteError(w, http.StatusNotFound, fmt.Sprintf(
+				"%s/%s not found", DEPLOYMENTS, r.PathValue("id")))
+			return
+		}
+
+		// Requests are completed when the deployment is retrieved
+		status, _ := deployment["status"].(string)
+		if status == "DELETE_INPROGRESS" {
+			self.Delete(DEPLOYMENTS, r.PathValue("id"))
+			self.GetOr404(w, DEPLOYMENTS, r.PathValue("id"))
+			return
+		}
+		if action, found := strings.CutSuffix(status, "_INPROGRESS"); found {
+			self.CompleteDeploymentRequest(deployment, action)
+		}
+
+		result := maps.Clone(deployment)
+		delete(result, "content")
+		delete(result, "visible")
+		if r.URL.Query().Get("expand") != "resources" {
+			delete(result, "resources")
+		}
+		self.WriteJSON(w, http.StatusOK, result)
+	})
+
+	// Only the name and the description can be changed
+	self.Handle("PATCH "+DEPLOYMENTS+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		deployment := self.GetOr404(w, DEPLOYMENTS, r.PathValue("id"))
+		if deployment == nil {
+			return
+		}
+		body, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		for _, key := range []string{"name", "description"} {
+			if value, found := body[key]; found {
+				deployment[key] = value
+			}
+		}
+		deployment["lastUpdatedAt"] = Now()
+		deployment["lastUpdatedBy"] = USERNAME
+		self.WriteJSON(w, http.StatusOK, deployment)
+	})
+
+	// Day-2 requests, only updating the inputs is implemented
+	self.Handle(
+		"POST "+DEPLOYMENTS+"/{id}/requests",
+		func(w http.ResponseWriter, r *http.Request) {
+			deployment := self.GetOr404(w, DEPLOYMENTS, r.PathValue("id"))
+			if deployment == nil {
+				return
+			}
+			body, ok := self.ReadBody(w, r)
+			if !ok {
+				return
+			}
+			if body["actionId"] != "Deployment.Update" {
+				self.WriteError(w, http.StatusBadRequest, fmt.Sprintf(
+					"Action %v is not supported", body["actionId"]))
+				return
+			}
+			inputs, _ := deployment["inputs"].(map[string]any)
+			requested, _ := body["inputs"].(map[string]any)
+			maps.Copy(inputs, requested)
+
+			// The status of the deployment is updated once the request is retrieved
+			request := self.NewDeploymentRequest(deployment, "UPDATE")
+			self.WriteJSON(w, http.StatusOK, request)
+		})
+
+	// Requests are completed when retrieved
+	self.Handle(
+		"GET "+DEPLOYMENT_REQUESTS+"/{id}",
+		func(w http.ResponseWriter, r *http.Request) {
+			request := self.GetOr404(w, DEPLOYMENT_REQUESTS, r.PathValue("id"))
+			if request == nil {
+				return
+			}
+			deploymentId, _ := request["deploymentId"].(string)
+			deployment := self.Get(DEPLOYMENTS, deploymentId)
+			if request["status"] == "INPROGRESS" && deployment != nil {
+				action, _ := request["name"].(string)
+				self.CompleteDeploymentRequest(deployment, action)
+			}
+			self.WriteJSON(w, http.StatusOK, request)
+		})
+
+	self.Handle("DELETE "+DEPLOYMENTS+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		deployment := self.GetOr404(w, DEPLOYMENTS, r.PathValue("id"))
+		if deployment == nil {
+			return
+		}
+		request := self.StartDeploymentRequest(deployment, "DELETE")
+		self.WriteJSON(w, http.StatusOK, request)
+	})
+}
+
+// Create a deployment of the content (blueprint) with the requested inputs (and the defaults).
+// The create request is completed when the deployment is retrieved.
+func (self *Server) NewDeployment(body map[string]any, content string) map[string]any {
+	var blueprint struct {
+		Inputs map[string]map[string]any `yaml:"inputs"`
+	}
+	_ = yaml.Unmarshal([]byte(content), &blueprint)
+
+	inputs := map[string]any{}
+	for name, input := range blueprint.Inputs {
+		if value, found := input["default"]; found {
+			inputs[name] = YAMLToJSON(value)
+		}
+	}
+	requested, _ := body["inputs"].(map[string]any)
+	maps.Copy(inputs, requested)
+
+	now := Now()
+	deployment := map[string]any{
+		"id":            uuid.NewString(),
+		"name":          body["deploymentName"],
+		"description":   body["description"],
+		"projectId":     body["projectId"],
+		"inputs":        inputs,
+		"outputs":       map[string]any{},
+		"resources":     []any{},
+		"content":       content,
+		"createdAt":     now,
+		"createdBy":     USERNAME,
+		"lastUpdatedAt": now,
+		"lastUpdatedBy": USERNAME,
+		"orgId":         self.OrgId,
+	}
+	SetDefault(deployment, "description", "")
+	self.StartDeploymentRequest(deployment, "CREATE")
+	self.Put(DEPLOYMENTS, deployment["id"].(string), deployment)
+	return deployment
+}
+
+// Start a request (e.g. DELETE) on the deployment and return it.
+func (self *Server) StartDeploymentRequest(
+	deployment map[string]any,
+	action string,
+) map[string]any {
+	deployment["status"] = action + "_INPROGRESS"
+	return self.NewDeploymentRequest(deployment, action)
+}
+
+// Make a request (e.g. UPDATE) on the deployment and return it, the status of the deployment is
+// left untouched.
+func (self *Server) NewDeploymentRequest(
+	deployment map[string]any,
+	action string,
+) map[string]any {
+	request := map[string]any{
+		"id":           uuid.NewString(),
+		"name":         action,
+		"deploymentId": deployment["id"],
+		"status":       "INPROGRESS",
+		"details":      "",
+	}
+	self.Put(DEPLOYMENT_REQUESTS, request["id"].(string), request)
+	deployment["lastRequest"] = request
+	return request
+}
+
+// Complete the request (e.g. CREATE) of the deployment, the resources and outputs are computed from
+// the content (blueprint) and the inputs. The request is failing if the content or the inputs are
+// invalid.
+func (self *Server) CompleteDeploymentRequest(deployment map[string]any, action string) {
+	request, _ := deployment["lastRequest"].(map[string]any)
+	content, _ := deployment["content"].(string)
+	inputs, _ := deployment["inputs"].(map[string]any)
+	details := ""
+	if messages := ValidateBlueprint(content); len(messages) > 0 {
+		details = fmt.Sprintf("Invalid blueprint: %s", messages[0]["message"])
+	} else if message := ValidateInputs(content, inputs); len(message) > 0 {
+		details = fmt.Sprintf("Invalid inputs: %s", message)
+	}
+	if len(details) > 0 {
+		deployment["status"] = action + "_FAILED"
+		request["status"] = "FAILED"
+		request["details"] = details
+		return
+	}
+
+	var blueprint struct {
+		Resources map[string]map[string]any `yaml:"resources"`
+		Outputs   map[string]map[string]any `yaml:"outputs"`
+	}
+	_ = yaml.Unmarshal([]byte(content), &blueprint)
+
+	// Resources are kept (by name) when the deployment is updated
+	existing := map[string]any{}
+	resources, _ := deployment["resources"].([]any)
+	for _, resource := range resources {
+		resource := resource.(map[string]any)
+		existing[resource["name"].(string)] = resource["id"]
+	}
+
+	resources = []any{}
+	for _, name := range slices.Sorted(maps.Keys(blueprint.Resources)) {
+		resource := blueprint.Resources[name]
+		id, found := existing[name]
+		if !found {
+			id = uuid.NewString()
+		}
+		resources = append(resources, map[string]any{
+			"id":         id,
+			"name":       name,
+			"type":       resource["type"],
+			"state":      "OK",
+			"properties": ResolveInputs(YAMLToJSON(resource["properties"]), inputs),
+		})
+	}
+
+	outputs := map[string]any{}
+	for name, output := range blueprint.Outputs {
+		outputs[name] = map[string]any{
+			"value": ResolveInputs(YAMLToJSON(output["value"]), inputs),
+		}
+	}
+
+	deployment["resources"] = resources
+	deployment["outputs"] = outputs
+	deployment["status"] = action + "_SUCCESSFUL"
+	deployment["lastUpdatedAt"] = Now()
+	request["status"] = "SUCCESSFUL"
+}
+
+// Replace the references to the inputs (e.g. ${input.flavor}) by their value.
+// Only the strings made of a single reference are replaced (no expression are evaluated).
+func ResolveInputs(value any, inputs map[string]any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(value))
+		for key, item := range value {
+			result[key] = ResolveInputs(item, inputs)
+		}
+		return result
+	case []any:
+		result := make([]any, len(value))
+		for index, item := range value {
+			result[index] = ResolveInputs(item, inputs)
+		}
+		return result
+	case string:
+		name, found := strings.CutPrefix(value, "${input.")
+		if name, suffix := strings.CutSuffix(name, "}"); found && suffix {
+			if input, found := inputs[name]; found {
+				return input
+			}
+		}
+		return value
+	default:
+		return value
+	}
+}
+
+// Convert a value decoded from YAML (mappings have keys of any type) to a JSON compatible value.
+func YAMLToJSON(value any) any {
+	switch value := value.(type) {
+	case map[any]any:
+		result := make(map[string]any, len(value))
+		for key, item := range value {
+			result[fmt.Sprint(key)] = YAMLToJSON(item)
+		}
+		return result
+	case []any:
+		result := make([]any, len(value))
+		for index, item := range value {
+			result[index] = YAMLToJSON(item)
+		}
+		return result
+	default:
+		return value
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+func (self *Server) RegisterEventBroker() {
+	self.RegisterCollection(Collection{
+		Path:   "event-broker/api/subscriptions",
+		Upsert: true, // Subscriptions are updated by calling POST on the collection
+		OnSave: func(server *Server, item map[string]any) {
+			SetDefault(item, "orgId", server.OrgId)
+			SetDefault(item, "ownerId", USERNAME)
+			SetDefault(item, "subscriberId", "system-user")
+		},
+	})
+}
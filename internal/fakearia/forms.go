@@ -0,0 +1,100 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+func (self *Server) RegisterForms() {
+	forms := "form-service/api/forms"
+	self.RegisterCollection(Collection{
+		Path:   forms,
+		Upsert: true, // Forms are updated by calling POST on the collection
+		OnSave: func(server *Server, item map[string]any) {
+			SetDefault(item, "tenant", server.OrgId)
+			SetDefault(item, "status", "ON")
+		},
+	})
+
+	self.Handle("GET "+forms+"/fetchBySourceAndType", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		for _, item := range self.List(forms) {
+			if item["sourceId"] == query.Get("sourceId") &&
+				item["sourceType"] == query.Get("sourceType") &&
+				item["type"] == query.Get("formType") {
+				self.WriteJSON(w, http.StatusOK, item)
+				return
+			}
+		}
+		self.WriteError(w, http.StatusNotFound, "Form not found")
+	})
+
+	self.RegisterCollection(Collection{
+		Path:         "form-service/api/custom/resource-actions",
+		CreateStatus: http.StatusOK,
+		Upsert:       true, // Actions are updated by calling POST on the collection
+		OnSave: func(server *Server, item map[string]any) {
+			SetDefault(item, "orgId", server.OrgId)
+			server.SetActionForm(item)
+		},
+	})
+
+	types := "form-service/api/custom/resource-types"
+	self.RegisterCollection(Collection{
+		Path:         types,
+		CreateStatus: http.StatusOK,
+		Upsert:       true, // Resource types are updated by calling POST on the collection
+		OnSave: func(server *Server, item map[string]any) {
+			SetDefault(item, "orgId", server.OrgId)
+			actions, _ := item["additionalActions"].([]any)
+			for _, action := range actions {
+				if action, ok := action.(map[string]any); ok {
+					SetDefault(action, "id", uuid.NewString())
+					SetDefault(action, "orgId", server.OrgId)
+					server.SetActionForm(action)
+				}
+			}
+		},
+	})
+
+	// Additional actions of the custom resources
+	self.Handle(
+		"GET "+types+"/{id}/resource-actions/{actionId}",
+		func(w http.ResponseWriter, r *http.Request) {
+			item := self.GetOr404(w, types, r.PathValue("id"))
+			if item == nil {
+				return
+			}
+			actions, _ := item["additionalActions"].([]any)
+			for _, action := range actions {
+				if action, ok := action.(map[string]any); ok && action["id"] == r.PathValue("actionId") {
+					self.WriteJSON(w, http.StatusOK, action)
+					return
+				}
+			}
+			self.WriteError(w, http.StatusNotFound, r.PathValue("actionId")+" not found")
+		})
+}
+
+// Complete (or generate) the request form of a resource action, like Aria does.
+func (self *Server) SetActionForm(action map[string]any) {
+	form, _ := action["formDefinition"].(map[string]any)
+	if form == nil {
+		form = map[string]any{}
+		action["formDefinition"] = form
+	}
+	SetDefault(form, "id", uuid.NewString())
+	SetDefault(form, "name", action["name"])
+	SetDefault(form, "type", "requestForm")
+	SetDefault(form, "form", `{"layout":{"pages":[]},"schema":{}}`)
+	SetDefault(form, "formFormat", "JSON")
+	SetDefault(form, "styles", "")
+	SetDefault(form, "sourceId", action["id"])
+	SetDefault(form, "sourceType", "resource.action")
+	SetDefault(form, "tenant", self.OrgId)
+	SetDefault(form, "status", "ON")
+}
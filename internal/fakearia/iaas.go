@@ -0,0 +1,279 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+func (self *Server) RegisterIaaS() {
+	self.RegisterIaaSCloudAccounts()
+	self.RegisterIaaSNaming()
+	self.RegisterIaaSProfiles()
+	self.RegisterIaaSRequestTrackers()
+	self.RegisterIaaSTags()
+	self.RegisterIaaSZones()
+}
+
+// Cloud accounts are created, updated and deleted asynchronously (a request tracker is returned),
+// the requests are finished immediately.
+func (self *Server) RegisterIaaSCloudAccounts() {
+	path := "iaas/api/cloud-accounts-vsphere"
+
+	// Credentials are never returned and regions are returned (with an identifier) as enabled
+	save := func(id string, item map[string]any) {
+		existingRegions := map[any]any{}
+		if existing := self.Get(path, id); existing != nil {
+			enabledRegions, _ := existing["enabledRegions"].([]any)
+			for _, region := range enabledRegions {
+				region := region.(map[string]any)
+				existingRegions[region["externalRegionId"]] = region["id"]
+			}
+		}
+		enabledRegions := []any{}
+		regions, _ := item["regions"].([]any)
+		for _, region := range regions {
+			region := region.(map[string]any)
+			regionId, found := existingRegions[region["externalRegionId"]]
+			if !found {
+				regionId = strings.ReplaceAll(uuid.NewString(), "-", "")
+			}
+			enabledRegions = append(enabledRegions, map[string]any{
+				"id":               regionId,
+				"externalRegionId": region["externalRegionId"],
+				"name":             region["name"],
+			})
+		}
+		item["id"] = id
+		item["enabledRegions"] = enabledRegions
+		for _, key := range []string{
+			"acceptSelfSignedCertificate", "createDefaultZones", "password", "regions",
+		} {
+			delete(item, key)
+		}
+		SetDefault(item, "description", "")
+		SetDefault(item, "dcid", "")
+		SetDefault(item, "tags", []any{})
+		SetDefault(item, "customProperties", map[string]any{})
+		item["orgId"] = self.OrgId
+		self.Put(path, id, item)
+	}
+
+	self.Handle("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		self.WritePage(w, r, self.List(path))
+	})
+
+	self.Handle("POST "+path, func(w http.ResponseWriter, r *http.Request) {
+		item, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		if regions, _ := item["regions"].([]any); len(regions) == 0 {
+			self.WriteError(w, http.StatusBadRequest, "At least one region is required")
+			return
+		}
+		id := strings.ReplaceAll(uuid.NewString(), "-", "")
+		save(id, item)
+		self.WriteJSON(w, http.StatusAccepted, self.NewRequestTracker("Cloud account creation",
+			"/iaas/api/cloud-accounts/"+id))
+	})
+
+	self.Handle("GET "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if item := self.GetOr404(w, path, r.PathValue("id")); item != nil {
+			self.WriteJSON(w, http.StatusOK, item)
+		}
+	})
+
+	self.Handle("PATCH "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		existing := self.GetOr404(w, path, id)
+		if existing == nil {
+			return
+		}
+		item, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		for key, value := range item {
+			existing[key] = value
+		}
+		save(id, existing)
+		self.WriteJSON(w, http.StatusAccepted, self.NewRequestTracker("Cloud account update",
+			"/iaas/api/cloud-accounts/"+id))
+	})
+
+	self.Handle("DELETE "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if self.GetOr404(w, path, r.PathValue("id")) != nil {
+			self.Delete(path, r.PathValue("id"))
+			self.WriteJSON(w, http.StatusAccepted, self.NewRequestTracker("Cloud account deletion"))
+		}
+	})
+}
+
+func (self *Server) RegisterIaaSNaming() {
+	path := "iaas/api/naming"
+	collection := Collection{
+		Path: path,
+		OnSave: func(server *Server, item map[string]any) {
+			projects, _ := item["projects"].([]any)
+			for _, project := range projects {
+				if project, ok := project.(map[string]any); ok {
+					SetDefault(project, "id", uuid.NewString())
+					SetDefault(project, "orgId", server.OrgId)
+				}
+			}
+			templates, _ := item["templates"].([]any)
+			for _, template := range templates {
+				if template, ok := template.(map[string]any); ok {
+					SetDefault(template, "id", uuid.NewString())
+					SetDefault(template, "counters", []any{})
+				}
+			}
+		},
+	}
+	self.RegisterCollection(collection)
+
+	// Naming are updated by calling PUT on the collection
+	self.Handle("PUT "+path, func(w http.ResponseWriter, r *http.Request) {
+		item, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		id, _ := item["id"].(string)
+		if self.GetOr404(w, path, id) == nil {
+			return
+		}
+		self.Save(collection, id, item)
+		self.WriteJSON(w, http.StatusOK, item)
+	})
+}
+
+// Flavor and image profiles, the mapping is sent as (flavor|image)Mapping and returned as
+// (flavor|image)Mappings.mapping.
+func (self *Server) RegisterIaaSProfiles() {
+	for path, field := range map[string]string{
+		"iaas/api/flavor-profiles": "flavorMapping",
+		"iaas/api/image-profiles":  "imageMapping",
+	} {
+		self.RegisterCollection(Collection{
+			Path: path,
+			OnSave: func(server *Server, item map[string]any) {
+				if mapping, ok := item[field]; ok {
+					item[field+"s"] = map[string]any{"mapping": mapping}
+					delete(item, field)
+				}
+				SetDefault(item, "description", "")
+				if regionId, ok := item["regionId"].(string); ok {
+					region := server.FindRegion(regionId)
+					if region == nil {
+						region = map[string]any{}
+					}
+					item["externalRegionId"] = region["externalRegionId"]
+					item["cloudAccountId"] = region["cloudAccountId"]
+				}
+				item["orgId"] = server.OrgId
+				SetRegionLink(item)
+			},
+		})
+	}
+}
+
+// Return the region (enabled in a cloud account) with given identifier, nil if not found.
+// The identifier of the cloud account is added to the region.
+func (self *Server) FindRegion(id string) map[string]any {
+	for _, account := range self.List("iaas/api/cloud-accounts-vsphere") {
+		regions, _ := account["enabledRegions"].([]any)
+		for _, region := range regions {
+			if region, ok := region.(map[string]any); ok && region["id"] == id {
+				return map[string]any{
+					"id":               id,
+					"externalRegionId": region["externalRegionId"],
+					"name":             region["name"],
+					"cloudAccountId":   account["id"],
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (self *Server) RegisterIaaSTags() {
+	path := "iaas/api/tags"
+	self.Handle("POST "+path, func(w http.ResponseWriter, r *http.Request) {
+		item, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		// Tags are unique (key, value), the identifier is derived from those
+		id := uuid.NewSHA1(ICONS_NAMESPACE, []byte(item["key"].(string)+":"+item["value"].(string)))
+		item["id"] = strings.ReplaceAll(id.String(), "-", "")
+		self.Put(path, item["id"].(string), item)
+		self.WriteJSON(w, http.StatusCreated, item)
+	})
+
+	self.Handle("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		self.WritePage(w, r, self.List(path))
+	})
+
+	self.Handle("DELETE "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if self.GetOr404(w, path, r.PathValue("id")) != nil {
+			self.Delete(path, r.PathValue("id"))
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+}
+
+func (self *Server) RegisterIaaSRequestTrackers() {
+	path := "iaas/api/request-tracker"
+	self.Handle("GET "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if item := self.GetOr404(w, path, r.PathValue("id")); item != nil {
+			self.WriteJSON(w, http.StatusOK, item)
+		}
+	})
+}
+
+// Return a new request tracker, finished, of the resources (paths).
+func (self *Server) NewRequestTracker(name string, resources ...string) map[string]any {
+	id := uuid.NewString()
+	tracker := map[string]any{
+		"id":        id,
+		"name":      name,
+		"progress":  100,
+		"status":    "FINISHED",
+		"message":   "",
+		"resources": resources,
+		"selfLink":  "/iaas/api/request-tracker/" + id,
+	}
+	self.Put("iaas/api/request-tracker", id, tracker)
+	return tracker
+}
+
+func (self *Server) RegisterIaaSZones() {
+	self.RegisterCollection(Collection{
+		Path: "iaas/api/zones",
+		OnSave: func(server *Server, item map[string]any) {
+			SetDefault(item, "description", "")
+			SetDefault(item, "placementPolicy", "DEFAULT")
+			SetDefault(item, "folder", "")
+			SetDefault(item, "tags", []any{})
+			SetDefault(item, "tagsToMatch", []any{})
+			SetDefault(item, "customProperties", map[string]any{})
+			item["orgId"] = server.OrgId
+			SetRegionLink(item)
+		},
+	})
+}
+
+// The region is not returned as regionId but as a link (like the real API).
+func SetRegionLink(item map[string]any) {
+	if regionId, ok := item["regionId"].(string); ok {
+		item["_links"] = map[string]any{
+			"region": map[string]any{"href": "/iaas/api/regions/" + regionId},
+		}
+		delete(item, "regionId")
+	}
+}
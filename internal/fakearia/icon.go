@@ -0,0 +1,63 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// Namespace of the icons identifiers (identifier is derived from the content).
+var ICONS_NAMESPACE = uuid.MustParse("8f1c7e2a-3b9d-4c61-a0e4-5d2b7f9c1e36")
+
+func (self *Server) RegisterIcon() {
+	path := "icon/api/icons"
+
+	self.Handle("POST "+path, func(w http.ResponseWriter, r *http.Request) {
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			self.WriteError(w, http.StatusBadRequest, "Missing file: "+err.Error())
+			return
+		}
+		defer file.Close()
+		content, err := io.ReadAll(file)
+		if err != nil {
+			self.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		id := self.PutIcon(content)
+		w.Header().Set("Location", self.BaseURL(r)+"/"+path+"/"+id)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	self.Handle("GET "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		content, found := self.files[path][r.PathValue("id")]
+		if !found {
+			self.WriteError(w, http.StatusNotFound, r.PathValue("id")+" not found")
+			return
+		}
+		w.Header().Set("Content-Type", http.DetectContentType(content))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(content)
+	})
+
+	// Deleting an icon is idempotent (the same icon may be "owned" by multiple resources)
+	self.Handle("DELETE "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		delete(self.files[path], r.PathValue("id"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// Store an icon and return its identifier (the same content always has the same identifier).
+func (self *Server) PutIcon(content []byte) string {
+	path := "icon/api/icons"
+	id := uuid.NewSHA1(ICONS_NAMESPACE, content).String()
+	if self.files[path] == nil {
+		self.files[path] = map[string][]byte{}
+	}
+	self.files[path][id] = content
+	return id
+}
@@ -0,0 +1,310 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+func (self *Server) RegisterOrchestrator() {
+	self.RegisterOrchestratorCategories()
+	self.RegisterOrchestratorWorkflows()
+
+	self.RegisterCollection(Collection{
+		Path:            "vco/api/actions",
+		UpdateNoContent: true,
+		OnSave: func(server *Server, item map[string]any) {
+			item["fqn"] = fmt.Sprintf("%s/%s", item["module"], item["name"])
+		},
+	})
+
+	// Actions can also be retrieved by their fully qualified name
+	readByFQN := func(w http.ResponseWriter, r *http.Request) {
+		fqn := r.PathValue("module") + "/" + r.PathValue("name")
+		for _, item := range self.List("vco/api/actions") {
+			if item["fqn"] == fqn {
+				self.WriteJSON(w, http.StatusOK, item)
+				return
+			}
+		}
+		self.WriteError(w, http.StatusNotFound, fmt.Sprintf("Action %s not found", fqn))
+	}
+	self.Handle("GET vco/api/actions/{module}/{name}", readByFQN)
+
+	self.RegisterCollection(Collection{
+		Path:            "vco/api/configurations",
+		UpdateStatus:    http.StatusNoContent,
+		UpdateNoContent: true,
+		Changeset:       true,
+	})
+
+	self.RegisterCollection(Collection{
+		Path:         "vco/api/environments",
+		UpdateStatus: http.StatusAccepted,
+		Changeset:    true,
+		OnSave: func(server *Server, item map[string]any) {
+			item["status"] = "UP_TO_DATE"
+			item["bundleHash"] = strings.ReplaceAll(uuid.NewString(), "-", "")
+			item["dependenciesInstallExecutionId"] = uuid.NewString()
+		},
+	})
+
+	self.RegisterCollection(Collection{
+		Path:         "vco/api/environments/repositories",
+		UpdateStatus: http.StatusAccepted,
+	})
+
+	self.RegisterCollection(Collection{
+		Path:         "vco/api/tasks",
+		CreateStatus: http.StatusAccepted,
+		UpdateByPost: true,
+		OnSave: func(server *Server, item map[string]any) {
+			item["href"] = fmt.Sprintf("/vco/api/tasks/%s/", item["id"])
+			item["running-instance-id"] = uuid.NewString()
+			item["user"] = USERNAME
+		},
+	})
+}
+
+func (self *Server) RegisterOrchestratorCategories() {
+	path := "vco/api/categories"
+
+	create := func(w http.ResponseWriter, r *http.Request, parent map[string]any) {
+		item, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		item["id"] = uuid.NewString()
+		PlaceCategory(item, parent)
+		self.Put(path, item["id"].(string), item)
+		self.WriteJSON(w, http.StatusCreated, item)
+	}
+
+	self.Handle("POST "+path, func(w http.ResponseWriter, r *http.Request) {
+		create(w, r, nil)
+	})
+
+	self.Handle("POST "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if parent := self.GetOr404(w, path, r.PathValue("id")); parent != nil {
+			create(w, r, parent)
+		}
+	})
+
+	// Categories are returned as links with attributes, filtered by type if requested
+	self.Handle("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		categoryType := r.URL.Query().Get("categoryType")
+		items := []map[string]any{}
+		for _, item := range self.List(path) {
+			if len(categoryType) == 0 || item["type"] == categoryType {
+				items = append(items, item)
+			}
+		}
+		self.WriteLinks(w, r, path, items, func(item map[string]any) map[string]any {
+			return map[string]any{
+				"id":   item["id"],
+				"name": item["name"],
+				"type": item["type"],
+				"path": item["path"],
+			}
+		})
+	})
+
+	self.Handle("GET "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if item := self.GetOr404(w, path, r.PathValue("id")); item != nil {
+			self.WriteJSON(w, http.StatusOK, item)
+		}
+	})
+
+	// Only the name can be changed
+	self.Handle("PUT "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		item := self.GetOr404(w, path, r.PathValue("id"))
+		if item == nil {
+			return
+		}
+		body, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		item["name"] = body["name"]
+		PlaceCategory(item, self.Get(path, fmt.Sprint(body["parent-category-id"])))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	self.Handle("DELETE "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if self.GetOr404(w, path, id) == nil {
+			return
+		}
+		for _, other := range self.List(path) {
+			if other["parent-category-id"] == id {
+				self.WriteError(w, http.StatusConflict, "Category is not empty")
+				return
+			}
+		}
+		self.Delete(path, id)
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// Update path and path-ids of the category to make it a child of given parent (or root if nil).
+func PlaceCategory(item map[string]any, parent map[string]any) {
+	item["path"] = item["name"]
+	item["path-ids"] = []any{item["id"]}
+	delete(item, "parent-category-id")
+	if parent != nil {
+		item["path"] = fmt.Sprintf("%s/%s", parent["path"], item["name"])
+		item["path-ids"] = append(append([]any{}, parent["path-ids"].([]any)...), item["id"])
+		item["parent-category-id"] = parent["id"]
+	}
+}
+
+func (self *Server) RegisterOrchestratorWorkflows() {
+	path := "vco/api/workflows"
+
+	self.Handle("POST "+path, func(w http.ResponseWriter, r *http.Request) {
+		item, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		id, _ := item["id"].(string)
+		if len(id) == 0 {
+			id = uuid.NewString()
+		}
+		item["id"] = id
+		self.Put(path, id, item)
+		self.Put(path+"/content", id, map[string]any{
+			"id":           id,
+			"display-name": item["name"],
+			"category-id":  item["category-id"],
+			"version":      "0.0.0",
+			"input":        map[string]any{"param": []any{}},
+			"output":       map[string]any{"param": []any{}},
+		})
+		self.Put(path+"/forms", id, map[string]any{"forms": []any{}})
+		self.Put(path+"/versions", id, map[string]any{"commits": []any{}})
+		self.WriteJSON(w, http.StatusCreated, item)
+	})
+
+	// Workflows are returned as links with attributes
+	self.Handle("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		self.WriteLinks(w, r, path, self.List(path), func(item map[string]any) map[string]any {
+			categoryId, _ := item["category-id"].(string)
+			category := self.Get("vco/api/categories", categoryId)
+			if category == nil {
+				category = map[string]any{}
+			}
+			return map[string]any{
+				"id":           item["id"],
+				"name":         item["name"],
+				"description":  item["description"],
+				"version":      item["version"],
+				"categoryId":   categoryId,
+				"categoryName": category["name"],
+			}
+		})
+	})
+
+	self.Handle("POST "+path+"/{id}/versions", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		item := self.GetOr404(w, path, id)
+		if item == nil {
+			return
+		}
+		body, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		content, _ := body["workflowSchema"].(map[string]any)
+		if content == nil {
+			self.WriteError(w, http.StatusBadRequest, "Missing workflowSchema")
+			return
+		}
+		content["id"] = id
+		item["name"] = content["display-name"]
+		item["description"] = content["description"]
+		item["version"] = content["version"]
+		self.Put(path+"/content", id, content)
+		self.Put(path+"/forms", id, map[string]any{"forms": body["inputForms"]})
+
+		changeset := self.NewChangeset(path, id)
+		versions := self.Get(path+"/versions", id)
+		versions["commits"] = append([]any{map[string]any{
+			"commit": map[string]any{
+				"authorEmail":    USERNAME + "@fake-aria.local",
+				"authorName":     USERNAME,
+				"commitDate":     Now(),
+				"committerEmail": USERNAME + "@fake-aria.local",
+				"committerName":  USERNAME,
+				"message":        "",
+				"objectId":       changeset,
+				"parentId":       body["parentId"],
+			},
+		}}, versions["commits"].([]any)...)
+		self.WriteJSON(w, http.StatusCreated, map[string]any{"objectId": changeset})
+	})
+
+	self.Handle("GET "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if item := self.GetOr404(w, path, r.PathValue("id")); item != nil {
+			self.WriteJSON(w, http.StatusOK, item)
+		}
+	})
+
+	self.Handle("GET "+path+"/{id}/content", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if content := self.GetOr404(w, path+"/content", id); content != nil {
+			w.Header().Set("x-vro-changeset-sha", self.Changeset(path, id))
+			self.WriteJSON(w, http.StatusOK, content)
+		}
+	})
+
+	self.Handle("GET "+path+"/{id}/versions", func(w http.ResponseWriter, r *http.Request) {
+		if versions := self.GetOr404(w, path+"/versions", r.PathValue("id")); versions != nil {
+			self.WriteJSON(w, http.StatusOK, versions)
+		}
+	})
+
+	self.Handle("GET vco/api/forms/", func(w http.ResponseWriter, r *http.Request) {
+		id, found := strings.CutPrefix(r.URL.Query().Get("conditions"), "workflow=")
+		if !found {
+			self.WriteError(w, http.StatusBadRequest, "Only workflow= conditions are implemented")
+			return
+		}
+		if forms := self.GetOr404(w, path+"/forms", id); forms != nil {
+			self.WriteJSON(w, http.StatusOK, forms["forms"])
+		}
+	})
+
+	self.Handle("DELETE "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if self.GetOr404(w, path, id) == nil {
+			return
+		}
+		for _, collection := range []string{path, path + "/content", path + "/forms", path + "/versions"} {
+			self.Delete(collection, id)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	// Workflows are imported into the catalog service through the gateway
+	self.Handle("GET vro/workflows/{id}", func(w http.ResponseWriter, r *http.Request) {
+		item := self.GetOr404(w, path, r.PathValue("id"))
+		if item == nil {
+			return
+		}
+		self.WriteJSON(w, http.StatusOK, map[string]any{
+			"id":          item["id"],
+			"name":        item["name"],
+			"version":     item["version"],
+			"workflowId":  item["id"],
+			"href":        "/vro/workflows/" + r.PathValue("id"),
+			"selfLink":    "/vro/workflows/" + r.PathValue("id"),
+			"integration": self.VROIntegration(r),
+			"orgId":       self.OrgId,
+		})
+	})
+}
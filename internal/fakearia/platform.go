@@ -0,0 +1,21 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+import (
+	"net/http"
+)
+
+func (self *Server) RegisterPlatform() {
+	// Secrets are read-only (seeded)
+	path := "platform/api/secrets"
+	self.Handle("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		self.WritePage(w, r, self.List(path))
+	})
+	self.Handle("GET "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if item := self.GetOr404(w, path, r.PathValue("id")); item != nil {
+			self.WriteJSON(w, http.StatusOK, item)
+		}
+	})
+}
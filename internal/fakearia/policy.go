@@ -0,0 +1,24 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+func (self *Server) RegisterPolicy() {
+	path := "policy/api/policies"
+	self.RegisterCollection(Collection{
+		Path:   path,
+		Upsert: true, // Policies are updated by calling POST on the collection
+		OnSave: func(server *Server, item map[string]any) {
+			now := Now()
+			if existing := server.Get(path, item["id"].(string)); existing != nil {
+				item["createdAt"] = existing["createdAt"]
+				item["createdBy"] = existing["createdBy"]
+			}
+			SetDefault(item, "orgId", server.OrgId)
+			SetDefault(item, "createdAt", now)
+			SetDefault(item, "createdBy", USERNAME)
+			item["lastUpdatedAt"] = now
+			item["lastUpdatedBy"] = USERNAME
+		},
+	})
+}
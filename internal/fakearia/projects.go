@@ -0,0 +1,92 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+import (
+	"net/http"
+	"slices"
+)
+
+// Lists of principals of the projects, by role.
+var PROJECT_ROLES = map[string]string{
+	"administrator": "administrators",
+	"member":        "members",
+	"supervisor":    "supervisors",
+	"viewer":        "viewers",
+}
+
+func (self *Server) RegisterProjects() {
+	path := "project-service/api/projects"
+	collection := Collection{
+		Path: path,
+		OnSave: func(server *Server, item map[string]any) {
+			SetDefault(item, "orgId", server.OrgId)
+			SetDefault(item, "constraints", map[string]any{})
+			SetDefault(item, "properties", map[string]any{})
+			SetDefault(item, "zones", []any{})
+			SetDefault(item, "placementPolicy", "DEFAULT")
+			SetDefault(item, "machineNamingTemplate", "")
+			// The creator of the project is its administrator, unless given
+			SetDefault(item, "administrators", []any{
+				map[string]any{"email": USERNAME, "type": "user"},
+			})
+			for _, role := range []string{"members", "supervisors", "viewers"} {
+				SetDefault(item, role, []any{})
+			}
+		},
+	}
+	self.RegisterCollection(collection)
+
+	// Modify (add or change the role) and remove principals, the others are left untouched
+	self.Handle("PATCH "+path+"/{id}/principals", func(w http.ResponseWriter, r *http.Request) {
+		project := self.GetOr404(w, path, r.PathValue("id"))
+		if project == nil {
+			return
+		}
+		body, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		modify, _ := body["modify"].([]any)
+		remove, _ := body["remove"].([]any)
+		for _, principal := range slices.Concat(modify, remove) {
+			RemovePrincipal(project, principal.(map[string]any))
+		}
+		for _, principal := range modify {
+			principal := principal.(map[string]any)
+			key, found := PROJECT_ROLES[principal["role"].(string)]
+			if !found {
+				self.WriteError(w, http.StatusBadRequest, "Invalid role")
+				return
+			}
+			project[key] = append(project[key].([]any), map[string]any{
+				"email": principal["email"],
+				"type":  principal["type"],
+			})
+		}
+		self.Save(collection, r.PathValue("id"), project)
+		self.WriteJSON(w, http.StatusOK, project)
+	})
+
+	// IaaS API exposes the same projects (read-only here)
+	self.Handle("GET iaas/api/projects", func(w http.ResponseWriter, r *http.Request) {
+		self.WritePage(w, r, self.List(path))
+	})
+	self.Handle("GET iaas/api/projects/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if item := self.GetOr404(w, path, r.PathValue("id")); item != nil {
+			self.WriteJSON(w, http.StatusOK, item)
+		}
+	})
+}
+
+// Remove the principal (matched by email and type) from all the roles of the project.
+func RemovePrincipal(project map[string]any, principal map[string]any) {
+	for _, key := range PROJECT_ROLES {
+		principals, _ := project[key].([]any)
+		project[key] = slices.DeleteFunc(append([]any{}, principals...), func(item any) bool {
+			other := item.(map[string]any)
+			return other["email"] == principal["email"] && other["type"] == principal["type"]
+		})
+	}
+}
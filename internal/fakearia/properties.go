@@ -0,0 +1,13 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+func (self *Server) RegisterProperties() {
+	self.RegisterCollection(Collection{
+		Path: "properties/api/property-groups",
+		OnSave: func(server *Server, item map[string]any) {
+			SetDefault(item, "orgId", server.OrgId)
+		},
+	})
+}
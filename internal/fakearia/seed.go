@@ -0,0 +1,165 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Icon seeded at startup.
+const SEED_ICON = `<svg xmlns="http://www.w3.org/2000/svg" width="16" height="16">` +
+	`<circle cx="8" cy="8" r="8" fill="#1d428a"/></svg>`
+
+// Instances seeded at startup, that acceptance tests are expecting to exist.
+type Seeds struct {
+	ProjectIds      []string
+	ZoneId          string
+	CloudAccountId  string
+	RegionId        string
+	ABXActionId     string
+	CatalogItemId   string
+	CatalogItemType string
+	IconId          string
+	SecretId        string
+	ApproverName    string
+}
+
+func (self *Server) Seed() {
+	now := Now()
+
+	for index := range 3 {
+		id := uuid.NewString()
+		self.Put("project-service/api/projects", id, map[string]any{
+			"id":               id,
+			"name":             "fake-aria-project-" + string(rune('a'+index)),
+			"operationTimeout": 0,
+			"sharedResources":  true,
+			"constraints":      map[string]any{},
+			"properties":       map[string]any{},
+			"orgId":            self.OrgId,
+		})
+		self.Seeds.ProjectIds = append(self.Seeds.ProjectIds, id)
+	}
+
+	self.Seeds.CloudAccountId = strings.ReplaceAll(uuid.NewString(), "-", "")
+	self.Seeds.RegionId = strings.ReplaceAll(uuid.NewString(), "-", "")
+	self.Put("iaas/api/cloud-accounts-vsphere", self.Seeds.CloudAccountId, map[string]any{
+		"id":          self.Seeds.CloudAccountId,
+		"name":        "fake-aria-vcenter",
+		"description": "Cloud account seeded by the fake Aria API.",
+		"hostName":    "vcenter.fake-aria.local",
+		"username":    "administrator@vsphere.local",
+		"dcid":        "",
+		"enabledRegions": []any{map[string]any{
+			"id":               self.Seeds.RegionId,
+			"externalRegionId": "Datacenter:datacenter-3",
+			"name":             "fake-aria-datacenter",
+		}},
+		"tags":             []any{},
+		"customProperties": map[string]any{},
+		"orgId":            self.OrgId,
+	})
+
+	self.Seeds.ZoneId = uuid.NewString()
+	zone := map[string]any{
+		"id":               self.Seeds.ZoneId,
+		"name":             "fake-aria-zone",
+		"description":      "Cloud zone seeded by the fake Aria API.",
+		"regionId":         self.Seeds.RegionId,
+		"placementPolicy":  "DEFAULT",
+		"folder":           "",
+		"tags":             []any{},
+		"tagsToMatch":      []any{},
+		"customProperties": map[string]any{},
+		"orgId":            self.OrgId,
+	}
+	SetRegionLink(zone)
+	self.Put("iaas/api/zones", self.Seeds.ZoneId, zone)
+
+	self.Seeds.ABXActionId = strings.ReplaceAll(uuid.NewString(), "-", "")
+	self.Put("abx/api/resources/actions", self.Seeds.ABXActionId, map[string]any{
+		"id":             self.Seeds.ABXActionId,
+		"name":           "fake-aria-action",
+		"description":    "Action seeded by the fake Aria API.",
+		"provider":       "",
+		"actionType":     "SCRIPT",
+		"runtime":        "python",
+		"runtimeVersion": "3.10",
+		"memoryInMB":     128,
+		"timeoutSeconds": 60,
+		"entrypoint":     "handler",
+		"source":         "def handler(context, inputs):\n    return inputs\n",
+		"dependencies":   "",
+		"inputs":         map[string]any{},
+		"shared":         true,
+		"projectId":      self.Seeds.ProjectIds[0],
+		"orgId":          self.OrgId,
+	})
+
+	self.Seeds.IconId = self.PutIcon([]byte(SEED_ICON))
+
+	self.Seeds.CatalogItemId = uuid.NewString()
+	self.Seeds.CatalogItemType = "com.vmw.blueprint"
+	self.Put("catalog/api/admin/items", self.Seeds.CatalogItemId, map[string]any{
+		"id":          self.Seeds.CatalogItemId,
+		"name":        "fake-aria-catalog-item",
+		"description": "Catalog item seeded by the fake Aria API.",
+		"schema":      map[string]any{"type": "object", "properties": map[string]any{}},
+		"externalId":  uuid.NewString(),
+		"formId":      "",
+		"iconId":      self.Seeds.IconId,
+		"type": map[string]any{
+			"id":   self.Seeds.CatalogItemType,
+			"link": "/catalog/api/types/" + self.Seeds.CatalogItemType,
+			"name": CATALOG_TYPES[self.Seeds.CatalogItemType]["name"],
+		},
+		"sourceId":      uuid.NewString(),
+		"sourceName":    "fake-aria-catalog-source",
+		"createdAt":     now,
+		"createdBy":     USERNAME,
+		"lastUpdatedAt": now,
+		"lastUpdatedBy": USERNAME,
+	})
+
+	self.Seeds.SecretId = uuid.NewString()
+	self.Put("platform/api/secrets", self.Seeds.SecretId, map[string]any{
+		"id":          self.Seeds.SecretId,
+		"name":        "fake-aria-secret",
+		"description": "Secret seeded by the fake Aria API.",
+		"orgId":       self.OrgId,
+		"orgScoped":   true,
+		"projectIds":  []string{},
+		"createdAt":   now,
+		"createdBy":   USERNAME,
+		"updatedAt":   now,
+		"updatedBy":   USERNAME,
+	})
+
+	self.Seeds.ApproverName = "USER:" + USERNAME
+}
+
+// Return the Terraform variables used by the acceptance tests to target the seeded instances.
+func (self *Server) Variables() map[string]string {
+	return map[string]string{
+		"test_org_id":            self.OrgId,
+		"test_project_id":        self.Seeds.ProjectIds[0],
+		"test_project_ids":       strings.Join(self.Seeds.ProjectIds, ","),
+		"test_zone_id":           self.Seeds.ZoneId,
+		"test_cloud_account_id":  self.Seeds.CloudAccountId,
+		"test_region_id":         self.Seeds.RegionId,
+		"test_vsphere_hostname":  "vcenter.fake-aria.local",
+		"test_vsphere_username":  "administrator@vsphere.local",
+		"test_vsphere_password":  "fake-aria-password",
+		"test_vsphere_region":    "Datacenter:datacenter-3",
+		"test_abx_action_id":     self.Seeds.ABXActionId,
+		"test_catalog_item_id":   self.Seeds.CatalogItemId,
+		"test_catalog_item_type": self.Seeds.CatalogItemType,
+		"test_icon_id":           self.Seeds.IconId,
+		"test_secret_id":         self.Seeds.SecretId,
+		"test_approver_name":     self.Seeds.ApproverName,
+		"test_user_email":        USERNAME,
+	}
+}
@@ -0,0 +1,508 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakearia implements an in-memory fake of the Aria Automation API, limited to the
+// endpoints used by the provider. Its meant to run the acceptance tests without an Aria instance.
+package fakearia
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Server is a fake Aria API keeping its state in memory.
+type Server struct {
+	*httptest.Server
+
+	// Organization of the instances created by the server.
+	OrgId string
+
+	// Instances seeded at startup, that acceptance tests are expecting to exist.
+	Seeds Seeds
+
+	mux   *http.ServeMux
+	mutex sync.Mutex
+
+	// Instances by collection then by identifier
+	items map[string]map[string]map[string]any
+
+	// Files (e.g. icons) by collection then by identifier
+	files map[string]map[string][]byte
+
+	// Access tokens delivered by the login endpoints
+	tokens map[string]bool
+
+	// Version (changeset) of the instances by collection then by identifier
+	changesets map[string]map[string]string
+}
+
+// Options of a collection of instances served with a generic REST API.
+type Collection struct {
+	// Path of the collection (e.g. abx/api/resources/actions).
+	Path string
+
+	// Field of the instances holding their identifier (id by default).
+	IdField string
+
+	// Status codes of the API calls (201, 200 and 204 by default).
+	CreateStatus int
+	UpdateStatus int
+	DeleteStatus int
+
+	// Create or replace the instance if its identifier is given when calling POST.
+	Upsert bool
+
+	// Do not return the instance when updated (e.g. status 202 or 204).
+	UpdateNoContent bool
+
+	// Instance is updated by calling POST instead of PUT.
+	UpdateByPost bool
+
+	// Instances are versioned, version is returned in the x-vro-changeset-sha header.
+	Changeset bool
+
+	// Called on the instance before saving it (e.g. to set computed fields).
+	OnSave func(server *Server, item map[string]any)
+
+	// Called on the instance before returning it (e.g. to simulate asynchronous processing).
+	OnRead func(server *Server, item map[string]any)
+}
+
+// Start a new fake Aria API.
+func NewServer() *Server {
+	server := &Server{
+		OrgId:  uuid.NewString(),
+		mux:    http.NewServeMux(),
+		items:  map[string]map[string]map[string]any{},
+		files:  map[string]map[string][]byte{},
+		tokens: map[string]bool{},
+
+		changesets: map[string]map[string]string{},
+	}
+	server.RegisterAuth()
+	server.RegisterABX()
+	server.RegisterBlueprint()
+	server.RegisterCatalog()
+	server.RegisterDeployment()
+	server.RegisterEventBroker()
+	server.RegisterForms()
+	server.RegisterIaaS()
+	server.RegisterIcon()
+	server.RegisterPlatform()
+	server.RegisterPolicy()
+	server.RegisterProjects()
+	server.RegisterProperties()
+	server.RegisterOrchestrator()
+	server.Seed()
+	server.Server = httptest.NewServer(server)
+	return server
+}
+
+func (self *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if !self.IsAuthenticated(r) {
+		self.WriteError(w, http.StatusUnauthorized, "Missing or invalid access token")
+		return
+	}
+	if _, pattern := self.mux.Handler(r); len(pattern) == 0 {
+		self.WriteError(w, http.StatusNotImplemented, fmt.Sprintf(
+			"%s %s is not implemented by the fake Aria API", r.Method, r.URL.Path))
+		return
+	}
+	self.mux.ServeHTTP(w, r)
+}
+
+// Register a handler for given pattern (e.g. "GET vco/api/workflows/{id}/content").
+// Handlers are called with the server locked.
+func (self *Server) Handle(pattern string, handler http.HandlerFunc) {
+	method, path, _ := strings.Cut(pattern, " ")
+	self.mux.HandleFunc(method+" /"+path, handler)
+}
+
+// Register the generic REST API of a collection.
+func (self *Server) RegisterCollection(collection Collection) {
+	if len(collection.IdField) == 0 {
+		collection.IdField = "id"
+	}
+	if collection.CreateStatus == 0 {
+		collection.CreateStatus = http.StatusCreated
+	}
+	if collection.UpdateStatus == 0 {
+		collection.UpdateStatus = http.StatusOK
+	}
+	if collection.DeleteStatus == 0 {
+		collection.DeleteStatus = http.StatusNoContent
+	}
+
+	path := collection.Path
+	self.Handle("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		self.WritePage(w, r, self.List(path))
+	})
+
+	self.Handle("POST "+path, func(w http.ResponseWriter, r *http.Request) {
+		item, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		id, _ := item[collection.IdField].(string)
+		if len(id) == 0 || !collection.Upsert {
+			if len(id) > 0 && self.Get(path, id) != nil {
+				self.WriteError(w, http.StatusConflict, fmt.Sprintf("%s already exists", id))
+				return
+			}
+			if len(id) == 0 {
+				id = uuid.NewString()
+			}
+		}
+		item[collection.IdField] = id
+		self.Save(collection, id, item)
+		self.SetChangesetHeader(w, collection, id)
+		w.Header().Set("Location", fmt.Sprintf("%s/%s/%s", self.BaseURL(r), path, id))
+		self.WriteJSON(w, collection.CreateStatus, item)
+	})
+
+	self.Handle("GET "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if item := self.GetOr404(w, path, r.PathValue("id")); item != nil {
+			if collection.OnRead != nil {
+				collection.OnRead(self, item)
+			}
+			self.SetChangesetHeader(w, collection, r.PathValue("id"))
+			self.WriteJSON(w, http.StatusOK, item)
+		}
+	})
+
+	update := func(w http.ResponseWriter, r *http.Request, merge bool) {
+		id := r.PathValue("id")
+		existing := self.GetOr404(w, path, id)
+		if existing == nil {
+			return
+		}
+		item, ok := self.ReadBody(w, r)
+		if !ok {
+			return
+		}
+		if merge {
+			for key, value := range item {
+				existing[key] = value
+			}
+			item = existing
+		}
+		item[collection.IdField] = id
+		self.Save(collection, id, item)
+		self.SetChangesetHeader(w, collection, id)
+		if collection.UpdateNoContent {
+			w.WriteHeader(collection.UpdateStatus)
+		} else {
+			self.WriteJSON(w, collection.UpdateStatus, item)
+		}
+	}
+	updateMethod := "PUT "
+	if collection.UpdateByPost {
+		updateMethod = "POST "
+	}
+	self.Handle(updateMethod+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		update(w, r, false)
+	})
+	self.Handle("PATCH "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		update(w, r, true)
+	})
+
+	self.Handle("DELETE "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if self.GetOr404(w, path, r.PathValue("id")) != nil {
+			self.Delete(path, r.PathValue("id"))
+			w.WriteHeader(collection.DeleteStatus)
+		}
+	})
+}
+
+// Save an instance of the collection (calling its OnSave hook).
+func (self *Server) Save(collection Collection, id string, item map[string]any) {
+	if collection.OnSave != nil {
+		collection.OnSave(self, item)
+	}
+	self.Put(collection.Path, id, item)
+	if collection.Changeset {
+		self.NewChangeset(collection.Path, id)
+	}
+}
+
+// Generate and return a new version (changeset) of an instance.
+func (self *Server) NewChangeset(path string, id string) string {
+	if self.changesets[path] == nil {
+		self.changesets[path] = map[string]string{}
+	}
+	changeset := fmt.Sprintf("%x", sha1.Sum([]byte(uuid.NewString())))
+	self.changesets[path][id] = changeset
+	return changeset
+}
+
+// Return the version (changeset) of an instance.
+func (self *Server) Changeset(path string, id string) string {
+	return self.changesets[path][id]
+}
+
+// Set the version (changeset) of an instance in the response headers (if versioned).
+func (self *Server) SetChangesetHeader(w http.ResponseWriter, collection Collection, id string) {
+	if collection.Changeset {
+		w.Header().Set("x-vro-changeset-sha", self.Changeset(collection.Path, id))
+	}
+}
+
+// Return an instance, nil if not found.
+func (self *Server) Get(path string, id string) map[string]any {
+	return self.items[path][id]
+}
+
+// Return an instance, write a not found error and return nil if not found.
+func (self *Server) GetOr404(w http.ResponseWriter, path string, id string) map[string]any {
+	item := self.Get(path, id)
+	if item == nil {
+		self.WriteError(w, http.StatusNotFound, fmt.Sprintf("%s/%s not found", path, id))
+	}
+	return item
+}
+
+// Store an instance.
+func (self *Server) Put(path string, id string, item map[string]any) {
+	if self.items[path] == nil {
+		self.items[path] = map[string]map[string]any{}
+	}
+	self.items[path][id] = item
+}
+
+// Remove an instance.
+func (self *Server) Delete(path string, id string) {
+	delete(self.items[path], id)
+	delete(self.changesets[path], id)
+}
+
+// Return the instances of the collection, sorted by identifier (for reproducibility).
+func (self *Server) List(path string) []map[string]any {
+	ids := make([]string, 0, len(self.items[path]))
+	for id := range self.items[path] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	items := make([]map[string]any, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, self.items[path][id])
+	}
+	return items
+}
+
+// Return instances wrapped into a page (as returned by most APIs).
+func (self *Server) Page(items []map[string]any) map[string]any {
+	return map[string]any{
+		"content":          items,
+		"totalElements":    len(items),
+		"numberOfElements": len(items),
+		"totalPages":       1,
+		"number":           0,
+		"size":             max(len(items), 20),
+		"first":            true,
+		"last":             true,
+	}
+}
+
+// Filter ($filter) and paginate (page & size or $skip & $top) instances then write the page.
+func (self *Server) WritePage(w http.ResponseWriter, r *http.Request, items []map[string]any) {
+	items, err := FilterItems(items, r.URL.Query().Get("$filter"))
+	if err != nil {
+		self.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	self.WriteJSON(w, http.StatusOK, self.Paginate(r, items))
+}
+
+// Return the requested page of the instances (all instances if no pagination is requested).
+func (self *Server) Paginate(r *http.Request, items []map[string]any) map[string]any {
+	query := r.URL.Query()
+	size, number, skip := len(items), 0, 0
+	if top, err := strconv.Atoi(query.Get("$top")); err == nil && top > 0 {
+		size = top
+		skip, _ = strconv.Atoi(query.Get("$skip"))
+	} else if value, err := strconv.Atoi(query.Get("size")); err == nil && value > 0 {
+		size = value
+		number, _ = strconv.Atoi(query.Get("page"))
+		skip = number * size
+	}
+	content := Slice(items, skip, max(size, 1))
+	page := self.Page(content)
+	page["totalElements"] = len(items)
+	page["totalPages"] = (len(items) + max(size, 1) - 1) / max(size, 1)
+	page["number"] = number
+	page["size"] = size
+	page["first"] = skip == 0
+	page["last"] = skip+len(content) >= len(items)
+	return page
+}
+
+// Return at most count instances starting at given index.
+func Slice(items []map[string]any, start int, count int) []map[string]any {
+	start = min(max(start, 0), len(items))
+	return items[start:min(start+max(count, 0), len(items))]
+}
+
+// Return the instances matching the OData filter.
+// Only comparisons (eq & ne) joined by and are implemented, e.g. "name eq 'foo' and id ne 42".
+func FilterItems(items []map[string]any, filter string) ([]map[string]any, error) {
+	if len(strings.TrimSpace(filter)) == 0 {
+		return items, nil
+	}
+	type Comparison struct {
+		field    string
+		operator string
+		value    string
+	}
+	comparisons := []Comparison{}
+	for _, clause := range strings.Split(filter, " and ") {
+		parts := strings.SplitN(strings.TrimSpace(clause), " ", 3)
+		if len(parts) != 3 || (parts[1] != "eq" && parts[1] != "ne") {
+			return nil, fmt.Errorf("Filter %q is not implemented by the fake Aria API", clause)
+		}
+		value := strings.ReplaceAll(strings.Trim(parts[2], "'"), "''", "'")
+		comparisons = append(comparisons, Comparison{parts[0], parts[1], value})
+	}
+
+	filtered := []map[string]any{}
+	for _, item := range items {
+		matches := true
+		for _, comparison := range comparisons {
+			// Nested fields are separated by slashes (e.g. type/id)
+			var value any = item
+			for _, key := range strings.Split(comparison.field, "/") {
+				object, _ := value.(map[string]any)
+				value = object[key]
+			}
+			if (fmt.Sprint(value) == comparison.value) != (comparison.operator == "eq") {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
+// Filter (conditions) and paginate (startIndex & maxResult) instances then write them as links
+// with attributes (as returned by vRO APIs). Only name=value (equals) and name~value (contains)
+// conditions are implemented, on the attributes returned by given function.
+func (self *Server) WriteLinks(
+	w http.ResponseWriter,
+	r *http.Request,
+	path string,
+	items []map[string]any,
+	attributes func(item map[string]any) map[string]any,
+) {
+	query := r.URL.Query()
+	links := []map[string]any{}
+	for _, item := range items {
+		values := attributes(item)
+		matches := true
+		for _, condition := range query["conditions"] {
+			index := strings.IndexAny(condition, "=~")
+			if index <= 0 {
+				self.WriteError(w, http.StatusBadRequest, fmt.Sprintf(
+					"Condition %q is not implemented by the fake Aria API", condition))
+				return
+			}
+			value, _ := values[condition[:index]].(string)
+			if condition[index] == '=' {
+				matches = matches && value == condition[index+1:]
+			} else {
+				expected := strings.ToLower(condition[index+1:])
+				matches = matches && strings.Contains(strings.ToLower(value), expected)
+			}
+		}
+		if !matches {
+			continue
+		}
+		linkAttributes := []any{}
+		for _, name := range slices.Sorted(maps.Keys(values)) {
+			if values[name] != nil {
+				linkAttributes = append(linkAttributes, map[string]any{
+					"name": name, "value": values[name],
+				})
+			}
+		}
+		links = append(links, map[string]any{
+			"attributes": linkAttributes,
+			"href":       fmt.Sprintf("%s/%s/%s/", self.BaseURL(r), path, item["id"]),
+			"rel":        "down",
+		})
+	}
+
+	start, _ := strconv.Atoi(query.Get("startIndex"))
+	count, err := strconv.Atoi(query.Get("maxResult"))
+	if err != nil || count <= 0 {
+		count = len(links)
+	}
+	start = min(max(start, 0), len(links))
+	self.WriteJSON(w, http.StatusOK, map[string]any{
+		"link":  links[start:min(start+count, len(links))],
+		"start": start,
+		"total": len(links),
+	})
+}
+
+// Decode the JSON body of the request, write a bad request error if invalid.
+func (self *Server) ReadBody(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		self.WriteError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+	item := map[string]any{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &item); err != nil {
+			self.WriteError(w, http.StatusBadRequest, "Invalid JSON body: "+err.Error())
+			return nil, false
+		}
+	}
+	return item, true
+}
+
+func (self *Server) WriteJSON(w http.ResponseWriter, status int, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+func (self *Server) WriteError(w http.ResponseWriter, status int, message string) {
+	self.WriteJSON(w, status, map[string]any{"message": message, "statusCode": status})
+}
+
+// Return the URL of the server as seen by the client.
+func (self *Server) BaseURL(r *http.Request) string {
+	return "http://" + r.Host
+}
+
+// Return the current time formatted as returned by the API.
+func Now() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+// Set the value of the field if not already set (missing, null or empty string).
+func SetDefault(item map[string]any, key string, value any) {
+	if current, found := item[key]; !found || current == nil || current == "" {
+		item[key] = value
+	}
+}
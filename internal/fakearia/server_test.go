@@ -0,0 +1,188 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearia
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func Call(
+	t *testing.T,
+	server *Server,
+	method string,
+	path string,
+	token string,
+	body any,
+) (*http.Response, map[string]any) {
+	var content []byte
+	if body != nil {
+		var err error
+		if content, err = json.Marshal(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	request, err := http.NewRequest(method, server.URL+"/"+path, bytes.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(token) > 0 {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	response, err := server.Client().Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	data := map[string]any{}
+	_ = json.NewDecoder(response.Body).Decode(&data)
+	return response, data
+}
+
+func Login(t *testing.T, server *Server) string {
+	response, data := Call(
+		t, server, "POST", "iaas/api/login", "", map[string]any{"refreshToken": REFRESH_TOKEN})
+	if response.StatusCode != 200 {
+		t.Fatalf("Login failed with status %d", response.StatusCode)
+	}
+	return data["token"].(string)
+}
+
+func TestServerAuthentication(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	response, _ := Call(t, server, "GET", "iaas/api/projects", "", nil)
+	if response.StatusCode != 401 {
+		t.Errorf("Expected status 401 without token, got %d", response.StatusCode)
+	}
+
+	response, _ = Call(
+		t, server, "POST", "iaas/api/login", "", map[string]any{"refreshToken": "wrong"})
+	if response.StatusCode != 400 {
+		t.Errorf("Expected status 400 with an invalid refresh token, got %d", response.StatusCode)
+	}
+
+	response, data := Call(t, server, "GET", "iaas/api/projects", Login(t, server), nil)
+	if response.StatusCode != 200 || data["totalElements"] != float64(3) {
+		t.Errorf("Expected the 3 seeded projects, got status %d and %v", response.StatusCode, data)
+	}
+}
+
+func TestServerCollection(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	token := Login(t, server)
+	path := "properties/api/property-groups"
+
+	response, created := Call(t, server, "POST", path, token, map[string]any{"name": "a"})
+	if response.StatusCode != 201 || created["orgId"] != server.OrgId {
+		t.Fatalf("Unexpected create response %d %v", response.StatusCode, created)
+	}
+	id := created["id"].(string)
+
+	response, updated := Call(t, server, "PUT", path+"/"+id, token, map[string]any{"name": "b"})
+	if response.StatusCode != 200 || updated["name"] != "b" || updated["id"] != id {
+		t.Errorf("Unexpected update response %d %v", response.StatusCode, updated)
+	}
+
+	response, _ = Call(t, server, "DELETE", path+"/"+id, token, nil)
+	if response.StatusCode != 204 {
+		t.Errorf("Expected status 204 on delete, got %d", response.StatusCode)
+	}
+
+	response, _ = Call(t, server, "GET", path+"/"+id, token, nil)
+	if response.StatusCode != 404 {
+		t.Errorf("Expected status 404 once deleted, got %d", response.StatusCode)
+	}
+
+	response, _ = Call(t, server, "GET", "some/api/unknown", token, nil)
+	if response.StatusCode != 501 {
+		t.Errorf("Expected status 501 for an unknown endpoint, got %d", response.StatusCode)
+	}
+}
+
+func TestServerPage(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	token := Login(t, server)
+
+	path := "project-service/api/projects"
+	for query, expected := range map[string][]any{
+		"?page=1&size=2":                         {float64(1), float64(3), true},
+		"?$skip=1&$top=1":                        {float64(1), float64(3), false},
+		"?$filter=name eq 'fake-aria-project-b'": {float64(1), float64(1), true},
+		"?$filter=name ne 'fake-aria-project-b'": {float64(2), float64(2), true},
+	} {
+		url := path + strings.ReplaceAll(query, " ", "%20")
+		response, page := Call(t, server, "GET", url, token, nil)
+		content, _ := page["content"].([]any)
+		actual := []any{float64(len(content)), page["totalElements"], page["last"]}
+		if response.StatusCode != 200 || fmt.Sprint(actual) != fmt.Sprint(expected) {
+			t.Errorf("Unexpected page %s %d %v (expected %v)",
+				query, response.StatusCode, actual, expected)
+		}
+	}
+
+	response, _ := Call(t, server, "GET", path+"?$filter=substringof('a',%20name)", token, nil)
+	if response.StatusCode != 400 {
+		t.Errorf("Expected status 400 for an unsupported filter, got %d", response.StatusCode)
+	}
+}
+
+func TestServerLinks(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	token := Login(t, server)
+
+	for _, name := range []string{"Alpha", "Beta", "Gamma"} {
+		category := map[string]any{"name": name, "type": "WorkflowCategory"}
+		Call(t, server, "POST", "vco/api/categories", token, category)
+	}
+
+	path := "vco/api/categories"
+	for query, expected := range map[string][]any{
+		"?startIndex=1&maxResult=1":             {float64(1), float64(3)},
+		"?conditions=name~a":                    {float64(3), float64(3)},
+		"?conditions=name=Beta":                 {float64(1), float64(1)},
+		"?categoryType=ActionCategory":          {float64(0), float64(0)},
+		"?conditions=name~m&conditions=path~al": {float64(0), float64(0)},
+	} {
+		response, page := Call(t, server, "GET", path+query, token, nil)
+		links, _ := page["link"].([]any)
+		actual := []any{float64(len(links)), page["total"]}
+		if response.StatusCode != 200 || fmt.Sprint(actual) != fmt.Sprint(expected) {
+			t.Errorf("Unexpected links %s %d %v (expected %v)",
+				query, response.StatusCode, actual, expected)
+		}
+	}
+
+	response, _ := Call(t, server, "GET", path+"?conditions=name", token, nil)
+	if response.StatusCode != 400 {
+		t.Errorf("Expected status 400 for an unsupported condition, got %d", response.StatusCode)
+	}
+}
+
+func TestServerIcon(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	if server.PutIcon([]byte("some icon")) != server.PutIcon([]byte("some icon")) {
+		t.Errorf("Icons with the same content must have the same identifier")
+	}
+	if server.PutIcon([]byte("some icon")) == server.PutIcon([]byte("other icon")) {
+		t.Errorf("Icons with a different content must have a different identifier")
+	}
+}
+
+func TestValidateBlueprint(t *testing.T) {
+	messages := ValidateBlueprint("resources:\n  A:\n    type: Cloud.Machine\n  B:\n    properties: {}\n")
+	if len(messages) != 1 || messages[0]["resourceName"] != "A" {
+		t.Errorf("Expected resource A to be invalid, got %v", messages)
+	}
+}
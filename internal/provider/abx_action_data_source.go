@@ -0,0 +1,102 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ABXActionDataSource{}
+
+func NewABXActionDataSource() datasource.DataSource {
+	return &ABXActionDataSource{}
+}
+
+// ABXActionDataSource defines the data source implementation.
+type ABXActionDataSource struct {
+	client *AriaClient
+}
+
+func (self *ABXActionDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_abx_action"
+}
+
+func (self *ABXActionDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = ABXActionDataSourceSchema()
+}
+
+func (self *ABXActionDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self ABXActionDataSource) ConfigValidators(
+	ctx context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (self *ABXActionDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var action ABXActionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &action)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Lookup the action (the project is required to read the action)
+	actionRaw, err := FindOne(ctx, self.client, action.ListQuery(), action.Match)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to find %s, got error: %s", action.String(), err))
+		return
+	}
+
+	var actionFromAPI ABXActionAPIModel
+	found, _, readDiags := self.client.ReadIt(ctx, ABXActionModel{
+		Id:        types.StringValue(actionRaw.Id),
+		ProjectId: types.StringValue(actionRaw.ProjectId),
+	}, &actionFromAPI)
+	resp.Diagnostics.Append(readDiags...)
+	if !found {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to find %s, got error: not found", action.String()))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save action into Terraform state
+	action.FromAPI(actionFromAPI)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &action)...)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccABXActionDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+variable "test_abx_action_id" {
+  description = "ABX action to lookup."
+  type        = string
+}
+
+data "aria_abx_action" "by_id" {
+  id = var.test_abx_action_id
+}
+
+data "aria_abx_action" "by_name" {
+  name       = data.aria_abx_action.by_id.name
+  project_id = data.aria_abx_action.by_id.project_id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aria_abx_action.by_id", "name"),
+					resource.TestCheckResourceAttrSet("data.aria_abx_action.by_id", "runtime_name"),
+					resource.TestCheckResourceAttrSet("data.aria_abx_action.by_id", "project_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aria_abx_action.by_name", "id",
+						"data.aria_abx_action.by_id", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.aria_abx_action.by_name", "source",
+						"data.aria_abx_action.by_id", "source",
+					),
+				),
+			},
+			// Lookup failure testing
+			{
+				Config: `
+data "aria_abx_action" "missing" {
+  name = "NO-ACTION-IS-NAMED-LIKE-THIS"
+}`,
+				ExpectError: regexp.MustCompile(`no\s+instance\s+of\s+abx/api/resources/actions`),
+			},
+		},
+	})
+}
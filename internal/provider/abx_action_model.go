@@ -45,6 +45,13 @@ type ABXActionModel struct {
 	OrgId     types.String `tfsdk:"org_id"`
 }
 
+// ABXActionDataSourceModel describes the data source data model.
+type ABXActionDataSourceModel struct {
+	ABXActionSummaryModel
+	Entrypoint types.String `tfsdk:"entrypoint"`
+	Source     types.String `tfsdk:"source"`
+}
+
 // ABXActionAPIModel describes the resource API model.
 type ABXActionAPIModel struct {
 	Id           string `json:"id,omitempty"`
@@ -75,6 +82,29 @@ type ABXActionAPIModel struct {
 	OrgId     string `json:"orgId"`
 }
 
+// Kinds of inputs exposing ABX constants and secrets to the action
+// (key is kind:id, value is empty).
+const ABX_INPUT_KIND_CONSTANT = "constant"
+const ABX_INPUT_KIND_SECRET = "secret"
+
+var ABX_INPUT_KEY_PREFIXES = map[string]string{
+	ABX_INPUT_KIND_CONSTANT: "secret",
+	ABX_INPUT_KIND_SECRET:   "psecret",
+}
+
+// Return the key of the input exposing the ABX constant or secret to the action.
+func ABXInputKey(kind string, id string) (string, error) {
+	prefix, found := ABX_INPUT_KEY_PREFIXES[kind]
+	if !found {
+		return "", fmt.Errorf(
+			"kind %s must be one of %s or %s", kind, ABX_INPUT_KIND_CONSTANT, ABX_INPUT_KIND_SECRET)
+	}
+	if len(id) == 0 {
+		return "", fmt.Errorf("identifier of the %s must not be empty", kind)
+	}
+	return prefix + ":" + id, nil
+}
+
 func (self ABXActionModel) String() string {
 	return fmt.Sprintf(
 		"ABX Action %s (%s) project %s",
@@ -90,6 +120,10 @@ func (self ABXActionModel) LockKey() string {
 	return "abx-action-" + self.Id.ValueString()
 }
 
+func (self ABXActionModel) ListPath() string {
+	return "abx/api/resources/actions"
+}
+
 func (self ABXActionModel) CreatePath() string {
 	return "abx/api/resources/actions"
 }
@@ -109,6 +143,45 @@ func (self ABXActionModel) DeletePath() string {
 	return self.ReadPath()
 }
 
+func (self ABXActionDataSourceModel) String() string {
+	return fmt.Sprintf(
+		"ABX Action (id %q, name %q, project %q)",
+		self.Id.ValueString(),
+		self.Name.ValueString(),
+		self.ProjectId.ValueString())
+}
+
+// Return the query retrieving the actions with the identifier or the name of the action to lookup.
+func (self ABXActionDataSourceModel) ListQuery() ListQuery {
+	filter := "name eq " + ODataString(self.Name.ValueString())
+	if len(self.Id.ValueString()) > 0 {
+		filter = "id eq " + ODataString(self.Id.ValueString())
+	}
+	return ListQuery{
+		Path:       ABXActionModel{}.ListPath(),
+		Pagination: LIST_PAGINATION_PAGE,
+		Filter:     filter,
+	}
+}
+
+// Return true if the action is matching the identifier or name and the project (if set).
+func (self ABXActionDataSourceModel) Match(raw ABXActionAPIModel) bool {
+	projectId := self.ProjectId.ValueString()
+	if len(projectId) > 0 && raw.ProjectId != projectId {
+		return false
+	}
+	if len(self.Id.ValueString()) > 0 {
+		return raw.Id == self.Id.ValueString()
+	}
+	return raw.Name == self.Name.ValueString()
+}
+
+func (self *ABXActionDataSourceModel) FromAPI(raw ABXActionAPIModel) {
+	self.ABXActionSummaryModel.FromAPI(raw)
+	self.Entrypoint = types.StringValue(raw.Entrypoint)
+	self.Source = types.StringValue(raw.Source)
+}
+
 func (self *ABXActionModel) FromAPI(
 	ctx context.Context,
 	raw ABXActionAPIModel,
@@ -123,6 +196,7 @@ func (self *ABXActionModel) FromAPI(
 	self.Name = types.StringValue(raw.Name)
 	self.Description = types.StringValue(raw.Description)
 	self.FAASProvider = types.StringValue(faasProvider)
+	self.Type = types.StringValue(raw.Type)
 	self.RuntimeName = types.StringValue(raw.RuntimeName)
 	self.RuntimeVersion = types.StringValue(raw.RuntimeVersion)
 	self.CPUShares = types.Int32Value(raw.CPUShares)
@@ -149,9 +223,9 @@ func (self *ABXActionModel) FromAPI(
 		if len(res) == 1 {
 			inputs[key], someDiags = JSONNormalizedFromAny(fmt.Sprintf("inputs[%s]", key), value)
 			diags.Append(someDiags...)
-		} else if res[0] == "secret" {
+		} else if res[0] == ABX_INPUT_KEY_PREFIXES[ABX_INPUT_KIND_CONSTANT] {
 			constantsIds = append(constantsIds, res[1])
-		} else if res[0] == "psecret" {
+		} else if res[0] == ABX_INPUT_KEY_PREFIXES[ABX_INPUT_KIND_SECRET] {
 			secretsIds = append(secretsIds, res[1])
 		} else {
 			// Unhandled -> inputsKeys
@@ -241,11 +315,20 @@ func (self ABXActionModel) ToAPI(
 	}
 
 	inputs := map[string]any{}
-	for _, constant := range constants {
-		inputs["secret:"+constant] = ""
-	}
-	for _, secret := range secrets {
-		inputs["psecret:"+secret] = ""
+	for kind, ids := range map[string][]string{
+		ABX_INPUT_KIND_CONSTANT: constants,
+		ABX_INPUT_KIND_SECRET:   secrets,
+	} {
+		for _, id := range ids {
+			key, err := ABXInputKey(kind, id)
+			if err != nil {
+				diags.AddError(
+					"Configuration error",
+					fmt.Sprintf("Unable to manage %s, %s", self.String(), err))
+				continue
+			}
+			inputs[key] = ""
+		}
 	}
 	for key, valueJSON := range inputsJSON {
 		inputs[key], someDiags = JSONNormalizedToAny(valueJSON)
@@ -69,7 +69,7 @@ func (self *ABXActionResource) Create(
 
 	var actionFromAPI ABXActionAPIModel
 	path := action.CreatePath()
-	response, err := self.client.R(path).SetBody(actionToAPI).SetResult(&actionFromAPI).Post(path)
+	response, err := self.client.R(ctx, path).SetBody(actionToAPI).SetResult(&actionFromAPI).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -97,7 +97,7 @@ func (self *ABXActionResource) Read(
 	}
 
 	var actionFromAPI ABXActionAPIModel
-	found, _, readDiags := self.client.ReadIt(&action, &actionFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &action, &actionFromAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -133,7 +133,7 @@ func (self *ABXActionResource) Update(
 
 	var actionFromAPI ABXActionAPIModel
 	path := action.UpdatePath()
-	response, err := self.client.R(path).SetBody(actionToAPI).SetResult(&actionFromAPI).Put(path)
+	response, err := self.client.R(ctx, path).SetBody(actionToAPI).SetResult(&actionFromAPI).Put(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -157,7 +157,7 @@ func (self *ABXActionResource) Delete(
 	var action ABXActionModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &action)...)
 	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&action)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &action)...)
 	}
 }
 
@@ -6,6 +6,7 @@ package provider
 import (
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dataschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -148,3 +149,50 @@ func ABXActionSchema() schema.Schema {
 		},
 	}
 }
+
+func ABXActionDataSourceSchema() dataschema.Schema {
+	return dataschema.Schema{
+		MarkdownDescription: "ABX action data source, lookup by identifier or name",
+		Attributes: map[string]dataschema.Attribute{
+			"id": OptionalIdentifierSchema(""),
+			"name": dataschema.StringAttribute{
+				MarkdownDescription: "Action name (must match exactly one action)",
+				Computed:            true,
+				Optional:            true,
+			},
+			"description": ComputedDescriptionSchema(),
+			"type": dataschema.StringAttribute{
+				MarkdownDescription: "Type of action, one of `SCRIPT`, `REST_CALL`, " +
+					"`REST_POLL`, `FLOW`, `VAULT` or `CYBERARK`",
+				Computed: true,
+			},
+			"runtime_name": dataschema.StringAttribute{
+				MarkdownDescription: "Runtime name (`python`, `nodejs`, ...)",
+				Computed:            true,
+			},
+			"runtime_version": dataschema.StringAttribute{
+				MarkdownDescription: "Runtime version (3.10, ...)",
+				Computed:            true,
+			},
+			"entrypoint": dataschema.StringAttribute{
+				MarkdownDescription: "Main function's name",
+				Computed:            true,
+			},
+			"source": dataschema.StringAttribute{
+				MarkdownDescription: "Action source code",
+				Computed:            true,
+			},
+			"shared": dataschema.BoolAttribute{
+				MarkdownDescription: "Flag indicating if the action can be shared across projects",
+				Computed:            true,
+			},
+			"system": dataschema.BoolAttribute{
+				MarkdownDescription: "Flag indicating if the action is a system action",
+				Computed:            true,
+			},
+			"project_id": OptionalIdentifierSchema(
+				"Project identifier (restrict the lookup to the actions of this project)"),
+			"org_id": ComputedOrganizationIdSchema(),
+		},
+	}
+}
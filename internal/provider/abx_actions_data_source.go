@@ -0,0 +1,72 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ABXActionsDataSource{}
+
+func NewABXActionsDataSource() datasource.DataSource {
+	return &ABXActionsDataSource{}
+}
+
+// ABXActionsDataSource defines the data source implementation.
+type ABXActionsDataSource struct {
+	client *AriaClient
+}
+
+func (self *ABXActionsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_abx_actions"
+}
+
+func (self *ABXActionsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = ABXActionsDataSourceSchema()
+}
+
+func (self *ABXActionsDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self *ABXActionsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var actions ABXActionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &actions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actionsFromAPI, err := ListAll[ABXActionAPIModel](ctx, self.client, actions.ListQuery())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to list %s, got error: %s", actions.String(), err))
+		return
+	}
+
+	// Save ABX actions into Terraform state
+	actions.FromAPI(actionsFromAPI)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &actions)...)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccABXActionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+variable "test_abx_action_id" {
+  description = "ABX action expected to be returned by the data source."
+  type        = string
+}
+
+data "aria_abx_actions" "all" {
+  lifecycle {
+    postcondition {
+      condition     = contains(self.actions[*].id, var.test_abx_action_id)
+      error_message = "ABX actions must include ${var.test_abx_action_id}"
+    }
+  }
+}
+
+data "aria_abx_actions" "filtered" {
+  filter = "id eq '${var.test_abx_action_id}'"
+
+  lifecycle {
+    postcondition {
+      condition     = join(",", self.actions[*].id) == var.test_abx_action_id
+      error_message = "ABX actions must be [${var.test_abx_action_id}]"
+    }
+  }
+}
+
+data "aria_abx_actions" "none" {
+  search = "NO-ACTION-IS-MATCHING-THIS-SEARCH"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aria_abx_actions.filtered", "actions.0.name"),
+					resource.TestCheckResourceAttrSet("data.aria_abx_actions.filtered", "actions.0.type"),
+					resource.TestCheckResourceAttrSet("data.aria_abx_actions.filtered", "actions.0.runtime_name"),
+					resource.TestCheckResourceAttrSet("data.aria_abx_actions.filtered", "actions.0.project_id"),
+					resource.TestCheckResourceAttr("data.aria_abx_actions.none", "actions.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ABXActionsModel describes the data source data model.
+type ABXActionsModel struct {
+	Filter types.String `tfsdk:"filter"`
+	Search types.String `tfsdk:"search"`
+
+	Actions []ABXActionSummaryModel `tfsdk:"actions"`
+}
+
+// ABXActionSummaryModel describes the actions returned by the data source.
+type ABXActionSummaryModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Type           types.String `tfsdk:"type"`
+	RuntimeName    types.String `tfsdk:"runtime_name"`
+	RuntimeVersion types.String `tfsdk:"runtime_version"`
+	Shared         types.Bool   `tfsdk:"shared"`
+	System         types.Bool   `tfsdk:"system"`
+	ProjectId      types.String `tfsdk:"project_id"`
+	OrgId          types.String `tfsdk:"org_id"`
+}
+
+func (self ABXActionsModel) String() string {
+	return fmt.Sprintf(
+		"ABX Actions (filter %q, search %q)",
+		self.Filter.ValueString(),
+		self.Search.ValueString())
+}
+
+func (self ABXActionsModel) ListQuery() ListQuery {
+	return ListQuery{
+		Path:       ABXActionModel{}.ListPath(),
+		Pagination: LIST_PAGINATION_PAGE,
+		Filter:     self.Filter.ValueString(),
+	}
+}
+
+func (self *ABXActionsModel) FromAPI(raws []ABXActionAPIModel) {
+	self.Actions = []ABXActionSummaryModel{}
+	for _, raw := range raws {
+		if MatchSearch(self.Search.ValueString(), raw.Name) {
+			action := ABXActionSummaryModel{}
+			action.FromAPI(raw)
+			self.Actions = append(self.Actions, action)
+		}
+	}
+}
+
+func (self *ABXActionSummaryModel) FromAPI(raw ABXActionAPIModel) {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Description = types.StringValue(raw.Description)
+	self.Type = types.StringValue(raw.Type)
+	self.RuntimeName = types.StringValue(raw.RuntimeName)
+	self.RuntimeVersion = types.StringValue(raw.RuntimeVersion)
+	self.Shared = types.BoolValue(raw.Shared)
+	self.System = types.BoolValue(raw.System)
+	self.ProjectId = types.StringValue(raw.ProjectId)
+	self.OrgId = types.StringValue(raw.OrgId)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func ABXActionsDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "ABX actions data source (all pages)",
+		Attributes: map[string]schema.Attribute{
+			"filter": ListFilterSchema(),
+			"search": ListSearchSchema(),
+			"actions": schema.ListNestedAttribute{
+				MarkdownDescription: "ABX actions (without their source code)",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": ComputedIdentifierSchema(""),
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Action name",
+							Computed:            true,
+						},
+						"description": ComputedDescriptionSchema(),
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of action, one of `SCRIPT`, `REST_CALL`, " +
+								"`REST_POLL`, `FLOW`, `VAULT` or `CYBERARK`",
+							Computed: true,
+						},
+						"runtime_name": schema.StringAttribute{
+							MarkdownDescription: "Runtime name (`python`, `nodejs`, ...)",
+							Computed:            true,
+						},
+						"runtime_version": schema.StringAttribute{
+							MarkdownDescription: "Runtime version (3.10, ...)",
+							Computed:            true,
+						},
+						"shared": schema.BoolAttribute{
+							MarkdownDescription: "Flag indicating if the action can be shared " +
+								"across projects",
+							Computed: true,
+						},
+						"system": schema.BoolAttribute{
+							MarkdownDescription: "Flag indicating if the action is a system action",
+							Computed:            true,
+						},
+						"project_id": ComputedIdentifierSchema("Project identifier"),
+						"org_id":     ComputedOrganizationIdSchema(),
+					},
+				},
+			},
+		},
+	}
+}
@@ -64,7 +64,7 @@ func (self *ABXConstantResource) Create(
 	var constantFromAPI ABXConstantAPIModel
 	path := constant.CreatePath()
 	body := constant.ToAPI()
-	response, err := self.client.R(path).SetBody(body).SetResult(&constantFromAPI).Post(path)
+	response, err := self.client.R(ctx, path).SetBody(body).SetResult(&constantFromAPI).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -92,7 +92,7 @@ func (self *ABXConstantResource) Read(
 	}
 
 	var constantFromAPI ABXConstantAPIModel
-	found, _, readDiags := self.client.ReadIt(&constant, &constantFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &constant, &constantFromAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -123,7 +123,7 @@ func (self *ABXConstantResource) Update(
 	var contantFromAPI ABXConstantAPIModel
 	path := constant.UpdatePath()
 	body := constant.ToAPI()
-	response, err := self.client.R(path).SetBody(body).SetResult(&contantFromAPI).Put(path)
+	response, err := self.client.R(ctx, path).SetBody(body).SetResult(&contantFromAPI).Put(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -147,7 +147,7 @@ func (self *ABXConstantResource) Delete(
 	var constant ABXConstantModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &constant)...)
 	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&constant)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &constant)...)
 	}
 }
 
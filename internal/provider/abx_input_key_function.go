@@ -0,0 +1,72 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ABXInputKeyFunction{}
+
+func NewABXInputKeyFunction() function.Function {
+	return &ABXInputKeyFunction{}
+}
+
+// ABXInputKeyFunction defines the function implementation.
+type ABXInputKeyFunction struct{}
+
+func (self *ABXInputKeyFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "abx_input_key"
+}
+
+func (self *ABXInputKeyFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Return the key of the input exposing an ABX constant or secret to an action",
+		MarkdownDescription: "Return the key of the input exposing an ABX constant (`secret:<id>`) " +
+			"or secret (`psecret:<id>`) to an action, the way `aria_abx_action` declares its " +
+			"`constants` and `secrets` in the inputs sent to the API.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "kind",
+				MarkdownDescription: "Kind of input, either `constant` or `secret`",
+			},
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "Identifier of the ABX constant or secret",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (self *ABXInputKeyFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var kind, id string
+	resp.Error = req.Arguments.Get(ctx, &kind, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	key, err := ABXInputKey(kind, id)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, key)
+}
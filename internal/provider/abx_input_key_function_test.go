@@ -0,0 +1,30 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestABXInputKeyFunction(t *testing.T) {
+	cases := []struct {
+		kind         string
+		id           string
+		key          string
+		errorMessage string
+	}{
+		{kind: "constant", id: "8a7480d3", key: "secret:8a7480d3"},
+		{kind: "secret", id: "8a7480d4", key: "psecret:8a7480d4"},
+		{kind: "password", id: "8a7480d5", errorMessage: "kind password must be one of"},
+		{kind: "secret", id: "", errorMessage: "identifier of the secret must not be empty"},
+	}
+	for _, c := range cases {
+		key := RunFunction(
+			t, NewABXInputKeyFunction(), c.errorMessage,
+			types.StringValue(c.kind), types.StringValue(c.id))
+		CheckEqual(t, key, c.key)
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ABXSensitiveConstantResource{}
+var _ ResourceWithSensitivePaths = &ABXSensitiveConstantResource{}
 
 func NewABXSensitiveConstantResource() resource.Resource {
 	return &ABXSensitiveConstantResource{}
@@ -39,6 +40,11 @@ func (self *ABXSensitiveConstantResource) Schema(
 	resp.Schema = ABXSensitiveConstantSchema()
 }
 
+// Sensitive attributes are redacted from the bodies of the sensitive constants API.
+func (self *ABXSensitiveConstantResource) SensitivePaths() []string {
+	return []string{ABXSensitiveConstantModel{}.CreatePath()}
+}
+
 func (self *ABXSensitiveConstantResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -62,7 +68,7 @@ func (self *ABXSensitiveConstantResource) Create(
 	var constantFromAPI ABXSensitiveConstantAPIModel
 	path := constant.CreatePath()
 	body := constant.ToAPI()
-	response, err := self.client.R(path).SetBody(body).SetResult(&constantFromAPI).Post(path)
+	response, err := self.client.R(ctx, path).SetBody(body).SetResult(&constantFromAPI).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -90,7 +96,7 @@ func (self *ABXSensitiveConstantResource) Read(
 	}
 
 	var constantFromAPI ABXSensitiveConstantAPIModel
-	found, _, readDiags := self.client.ReadIt(&constant, &constantFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &constant, &constantFromAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -121,7 +127,7 @@ func (self *ABXSensitiveConstantResource) Update(
 	var constantFromAPI ABXSensitiveConstantAPIModel
 	path := constant.UpdatePath()
 	body := constant.ToAPI()
-	response, err := self.client.R(path).SetBody(body).SetResult(&constantFromAPI).Put(path)
+	response, err := self.client.R(ctx, path).SetBody(body).SetResult(&constantFromAPI).Put(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -145,6 +151,6 @@ func (self *ABXSensitiveConstantResource) Delete(
 	var constant ABXSensitiveConstantModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &constant)...)
 	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&constant)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &constant)...)
 	}
 }
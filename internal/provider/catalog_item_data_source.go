@@ -75,7 +75,7 @@ func (self *CatalogItemDataSource) Read(
 	if len(item.Id.ValueString()) > 0 {
 		// Retrieve details from the item's API endpoint
 		path := item.ReadPath()
-		response, err := self.client.R(path).SetResult(&itemFromAPI).Get(path)
+		response, err := self.client.R(ctx, path).SetResult(&itemFromAPI).Get(path)
 		err = self.client.HandleAPIResponse(response, err, []int{200})
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -88,7 +88,7 @@ func (self *CatalogItemDataSource) Read(
 
 		var listFromAPI CatalogItemLstAPIModel
 		listPath := item.ListPath()
-		query := self.client.R(listPath)
+		query := self.client.R(ctx, listPath)
 
 		// Setup search query
 		name := item.Name.ValueString()
@@ -123,7 +123,7 @@ func (self *CatalogItemDataSource) Read(
 		for _, itemRaw := range listFromAPI.Content {
 			// Retrieve details from the item's API endpoint
 			path := CatalogItemModel{Id: types.StringValue(itemRaw.Id)}.ReadPath()
-			response, err = self.client.R(path).SetResult(&itemFromAPI).Get(path)
+			response, err = self.client.R(ctx, path).SetResult(&itemFromAPI).Get(path)
 			err = self.client.HandleAPIResponse(response, err, []int{200})
 			if err != nil {
 				resp.Diagnostics.AddError(
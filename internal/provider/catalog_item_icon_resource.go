@@ -62,7 +62,7 @@ func (self *CatalogItemIconResource) Create(
 	}
 
 	path := itemIcon.CreatePath()
-	response, err := self.client.R(path).SetBody(itemIcon.ToAPI()).Patch(path)
+	response, err := self.client.R(ctx, path).SetBody(itemIcon.ToAPI()).Patch(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -74,7 +74,7 @@ func (self *CatalogItemIconResource) Create(
 	// Read (using API) to retrieve the item content (and not empty stuff)
 	var itemIconFromAPI CatalogItemIconAPIModel
 	path = itemIcon.ReadPath()
-	response, err = self.client.R(path).SetResult(&itemIconFromAPI).Get(path)
+	response, err = self.client.R(ctx, path).SetResult(&itemIconFromAPI).Get(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -102,7 +102,7 @@ func (self *CatalogItemIconResource) Read(
 	}
 
 	var itemIconFromAPI CatalogItemIconAPIModel
-	found, _, readDiags := self.client.ReadIt(&itemIcon, &itemIconFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &itemIcon, &itemIconFromAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -131,7 +131,7 @@ func (self *CatalogItemIconResource) Update(
 	}
 
 	path := itemIcon.UpdatePath()
-	response, err := self.client.R(path).SetBody(itemIcon.ToAPI()).Patch(path)
+	response, err := self.client.R(ctx, path).SetBody(itemIcon.ToAPI()).Patch(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -143,7 +143,7 @@ func (self *CatalogItemIconResource) Update(
 	// Read (using API) to retrieve the item content (and not empty stuff)
 	var itemIconFromAPI CatalogItemIconAPIModel
 	path = itemIcon.ReadPath()
-	response, err = self.client.R(path).SetResult(&itemIconFromAPI).Get(path)
+	response, err = self.client.R(ctx, path).SetResult(&itemIconFromAPI).Get(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
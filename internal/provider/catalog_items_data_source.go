@@ -0,0 +1,72 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CatalogItemsDataSource{}
+
+func NewCatalogItemsDataSource() datasource.DataSource {
+	return &CatalogItemsDataSource{}
+}
+
+// CatalogItemsDataSource defines the data source implementation.
+type CatalogItemsDataSource struct {
+	client *AriaClient
+}
+
+func (self *CatalogItemsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_catalog_items"
+}
+
+func (self *CatalogItemsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = CatalogItemsDataSourceSchema()
+}
+
+func (self *CatalogItemsDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self *CatalogItemsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var items CatalogItemsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &items)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	itemsFromAPI, err := ListAll[CatalogItemAPIModel](ctx, self.client, items.ListQuery())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to list %s, got error: %s", items.String(), err))
+		return
+	}
+
+	// Save catalog items into Terraform state
+	resp.Diagnostics.Append(items.FromAPI(itemsFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &items)...)
+}
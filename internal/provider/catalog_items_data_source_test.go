@@ -0,0 +1,52 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCatalogItemsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+variable "test_catalog_item_id" {
+  description = "Catalog item expected to be returned by the data source."
+  type        = string
+}
+
+data "aria_catalog_item" "item" {
+  id = var.test_catalog_item_id
+}
+
+data "aria_catalog_items" "searched" {
+  search = data.aria_catalog_item.item.name
+
+  lifecycle {
+    postcondition {
+      condition     = contains(self.items[*].id, var.test_catalog_item_id)
+      error_message = "Catalog items must include ${var.test_catalog_item_id}"
+    }
+  }
+}
+
+data "aria_catalog_items" "none" {
+  search = "NO-CATALOG-ITEM-IS-MATCHING-THIS-SEARCH"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aria_catalog_items.searched", "items.0.id"),
+					resource.TestCheckResourceAttrSet("data.aria_catalog_items.searched", "items.0.name"),
+					resource.TestCheckResourceAttrSet("data.aria_catalog_items.searched", "items.0.type_id"),
+					resource.TestCheckResourceAttr("data.aria_catalog_items.none", "items.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CatalogItemsModel describes the data source data model.
+type CatalogItemsModel struct {
+	Filter types.String `tfsdk:"filter"`
+	Search types.String `tfsdk:"search"`
+
+	Items []CatalogItemModel `tfsdk:"items"`
+}
+
+func (self CatalogItemsModel) String() string {
+	return fmt.Sprintf(
+		"Catalog Items (filter %q, search %q)",
+		self.Filter.ValueString(),
+		self.Search.ValueString())
+}
+
+func (self CatalogItemsModel) ListQuery() ListQuery {
+	query := ListQuery{
+		Path:       CatalogItemModel{}.ListPath(),
+		Pagination: LIST_PAGINATION_PAGE,
+		Filter:     self.Filter.ValueString(),
+	}
+	// The API is searching into names and descriptions, the results are then filtered by name
+	if search := self.Search.ValueString(); len(search) > 0 {
+		query.Params = map[string]string{"search": search}
+	}
+	return query
+}
+
+func (self *CatalogItemsModel) FromAPI(raws []CatalogItemAPIModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	self.Items = []CatalogItemModel{}
+	for _, raw := range raws {
+		if MatchSearch(self.Search.ValueString(), raw.Name) {
+			item := CatalogItemModel{}
+			diags.Append(item.FromAPI(raw)...)
+			self.Items = append(self.Items, item)
+		}
+	}
+	return diags
+}
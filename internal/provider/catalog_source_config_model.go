@@ -30,10 +30,11 @@ func (self *CatalogSourceConfigModel) FromAPI(
 	self.SourceProjectId = types.StringValue(raw.SourceProjectId)
 
 	diags := diag.Diagnostics{}
+	attrs := types.ObjectType{AttrTypes: CatalogSourceWorkflowModel{}.AttributeTypes()}
 
 	// Convert workflows from raw to list
 	if raw.Workflows == nil {
-		self.Workflows = types.ListNull(self.Workflows.ElementType(ctx))
+		self.Workflows = types.ListNull(attrs)
 	} else {
 		workflows := []CatalogSourceWorkflowModel{}
 		for _, workflowRaw := range raw.Workflows {
@@ -43,9 +44,7 @@ func (self *CatalogSourceConfigModel) FromAPI(
 		}
 
 		var someDiags diag.Diagnostics
-		self.Workflows, someDiags = types.ListValueFrom(
-			ctx, self.Workflows.ElementType(ctx), workflows,
-		)
+		self.Workflows, someDiags = types.ListValueFrom(ctx, attrs, workflows)
 		diags.Append(someDiags...)
 	}
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -39,6 +40,8 @@ type CatalogSourceModel struct {
 
 	ImportTrigger types.String `tfsdk:"import_trigger"`
 	WaitImported  types.Bool   `tfsdk:"wait_imported"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // CatalogSourceAPIModel describes the resource API model.
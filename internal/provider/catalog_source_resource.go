@@ -39,7 +39,7 @@ func (self *CatalogSourceResource) Schema(
 	req resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
-	resp.Schema = CatalogSourceSchema()
+	resp.Schema = CatalogSourceSchema(ctx)
 }
 
 func (self *CatalogSourceResource) Configure(
@@ -62,6 +62,15 @@ func (self *CatalogSourceResource) Create(
 		return
 	}
 
+	createTimeout, timeoutDiags := source.Timeouts.Create(ctx, DEFAULT_IMPORT_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	sourceToAPI, someDiags := source.ToAPI(ctx)
 	resp.Diagnostics.Append(someDiags...)
 	if resp.Diagnostics.HasError() {
@@ -70,7 +79,7 @@ func (self *CatalogSourceResource) Create(
 
 	var sourceFromAPI CatalogSourceAPIModel
 	path := source.CreatePath()
-	response, err := self.client.R(path).SetBody(sourceToAPI).SetResult(&sourceFromAPI).Post(path)
+	response, err := self.client.R(ctx, path).SetBody(sourceToAPI).SetResult(&sourceFromAPI).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{201})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -103,7 +112,7 @@ func (self *CatalogSourceResource) Read(
 	}
 
 	var sourceFromAPI CatalogSourceAPIModel
-	found, _, readDiags := self.client.ReadIt(&source, &sourceFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &source, &sourceFromAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -131,6 +140,15 @@ func (self *CatalogSourceResource) Update(
 		return
 	}
 
+	updateTimeout, timeoutDiags := source.Timeouts.Update(ctx, DEFAULT_IMPORT_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	sourceToAPI, someDiags := source.ToAPI(ctx)
 	resp.Diagnostics.Append(someDiags...)
 	if resp.Diagnostics.HasError() {
@@ -139,7 +157,7 @@ func (self *CatalogSourceResource) Update(
 
 	var sourceFromAPI CatalogSourceAPIModel
 	path := source.UpdatePath()
-	response, err := self.client.R(path).SetBody(sourceToAPI).SetResult(&sourceFromAPI).Post(path)
+	response, err := self.client.R(ctx, path).SetBody(sourceToAPI).SetResult(&sourceFromAPI).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{201})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -167,9 +185,20 @@ func (self *CatalogSourceResource) Delete(
 	// Read Terraform prior state data into the model
 	var source CatalogSourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &source)...)
-	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, timeoutDiags := source.Timeouts.Delete(ctx, DEFAULT_DELETE_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(self.client.DeleteIt(ctx, &source)...)
 }
 
 // -------------------------------------------------------------------------------------------------
@@ -187,17 +216,21 @@ func (self *CatalogSourceResource) WaitImported(
 	name := source.String()
 	tflog.Debug(ctx, fmt.Sprintf("Wait %s to be imported...", name))
 
-	// Poll for catalog items to be imported up to 15 minutes (30 x 30 seconds)
-	maxAttempts := 30
-	for attempt := 0; attempt < maxAttempts; attempt++ {
+	// Poll for catalog items to be imported until the operation times out (see timeouts)
+	interval := self.client.GetPollInterval(ctx, time.Duration(30)*time.Second)
+	for attempt := 0; ; attempt++ {
 		// Poll resource until imported
-		time.Sleep(time.Duration(30) * time.Second)
+		if err := Sleep(ctx, interval); err != nil {
+			diags.Append(InterruptedDiagnostics(
+				err, fmt.Sprintf("waiting for %s to be imported", name))...)
+			return diags
+		}
 		tflog.Debug(
 			ctx,
-			fmt.Sprintf("Poll %d of %d - Check %s is imported...", attempt+1, maxAttempts, name))
+			fmt.Sprintf("Poll %d - Check %s is imported...", attempt+1, name))
 
 		var sourceFromAPI CatalogSourceAPIModel
-		found, _, someDiags := self.client.ReadIt(source, &sourceFromAPI)
+		found, _, someDiags := self.client.ReadIt(ctx, source, &sourceFromAPI)
 		diags.Append(someDiags...)
 		if !found {
 			diags.AddError(
@@ -228,7 +261,7 @@ func (self *CatalogSourceResource) WaitImported(
 			// Refresh and continue polling but only if there is no error (conversion, ...)
 			if !diags.HasError() {
 				path := source.UpdatePath()
-				response, err := self.client.R(path).SetBody(sourceToAPI).Post(path)
+				response, err := self.client.R(ctx, path).SetBody(sourceToAPI).Post(path)
 				err = self.client.HandleAPIResponse(response, err, []int{201})
 				if err == nil {
 					continue // Continue polling
@@ -258,9 +291,4 @@ func (self *CatalogSourceResource) WaitImported(
 		// Either successful or failing, its the end...
 		return diags
 	}
-
-	diags.AddError(
-		"Client error",
-		fmt.Sprintf("Timeout while waiting for %s to be imported without errors.", name))
-	return diags
 }
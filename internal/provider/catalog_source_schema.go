@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"context"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
@@ -15,7 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func CatalogSourceSchema() schema.Schema {
+func CatalogSourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Catalog source resource",
 		Attributes: map[string]schema.Attribute{
@@ -90,6 +91,10 @@ func CatalogSourceSchema() schema.Schema {
 					"One use case can be to ensure workflows are refreshed in service broker " +
 						"every time its changed, by using `workflow.version_id` as value for this.",
 					"",
+					"Another one is to import the cloud templates every time a new version is " +
+						"released, by using `aria_cloud_template_version.id` (or `version`) as " +
+						"value for this.",
+					"",
 				}, "\n"),
 				Optional: true,
 				Computed: true,
@@ -100,11 +105,14 @@ func CatalogSourceSchema() schema.Schema {
 			},
 			"wait_imported": schema.BoolAttribute{
 				MarkdownDescription: "Wait for import to be completed " +
-					"(up to 15 minutes, checked every 30 seconds, default is true)",
+					"(up to the create or update timeout, 20 minutes by default, default is true)",
 				Optional: true,
 				Computed: true,
 				Default:  booldefault.StaticBool(true),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": TimeoutsSchema(ctx),
+		},
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -92,3 +93,16 @@ func (self CatalogSourceWorkflowModel) ToAPI(
 		Integration: integrationRaw,
 	}, diags
 }
+
+// Utils -------------------------------------------------------------------------------------------
+
+// Used to convert structure to a types.Object.
+func (self CatalogSourceWorkflowModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"version":     types.StringType,
+		"integration": types.ObjectType{AttrTypes: IntegrationModel{}.AttributeTypes()},
+	}
+}
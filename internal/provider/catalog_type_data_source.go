@@ -60,7 +60,7 @@ func (self *CatalogTypeDataSource) Read(
 
 	var catalogTypeFromAPI CatalogTypeAPIModel
 	path := catalogType.ReadPath()
-	response, err := self.client.R(path).SetResult(&catalogTypeFromAPI).Get(path)
+	response, err := self.client.R(ctx, path).SetResult(&catalogTypeFromAPI).Get(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -0,0 +1,54 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CloudAccountRegionModel describes the resource data model.
+type CloudAccountRegionModel struct {
+	ExternalRegionId types.String `tfsdk:"external_region_id"`
+	Name             types.String `tfsdk:"name"`
+}
+
+// CloudAccountRegionAPIModel describes the resource API model.
+type CloudAccountRegionAPIModel struct {
+	Id               string `json:"id,omitempty"`
+	ExternalRegionId string `json:"externalRegionId"`
+	Name             string `json:"name"`
+}
+
+func (self *CloudAccountRegionModel) FromAPI(raw CloudAccountRegionAPIModel) {
+	self.ExternalRegionId = types.StringValue(raw.ExternalRegionId)
+	self.Name = types.StringValue(raw.Name)
+}
+
+func (self CloudAccountRegionModel) ToAPI() CloudAccountRegionAPIModel {
+	return CloudAccountRegionAPIModel{
+		ExternalRegionId: self.ExternalRegionId.ValueString(),
+		Name:             self.Name.ValueString(),
+	}
+}
+
+// IaaSLinksAPIModel describes the links to the related instances (e.g. the region of a cloud zone)
+// as returned by the IaaS API.
+type IaaSLinksAPIModel struct {
+	Region *IaaSLinkAPIModel `json:"region,omitempty"`
+}
+
+// IaaSLinkAPIModel describes a link to a related instance.
+type IaaSLinkAPIModel struct {
+	Href string `json:"href"`
+}
+
+// Return the identifier of the region, either given (if returned) or extracted from the links.
+func RegionIdFromAPI(regionId string, links *IaaSLinksAPIModel) string {
+	if len(regionId) == 0 && links != nil && links.Region != nil {
+		return path.Base(links.Region.Href)
+	}
+	return regionId
+}
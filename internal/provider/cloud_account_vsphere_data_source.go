@@ -0,0 +1,105 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CloudAccountVSphereDataSource{}
+
+func NewCloudAccountVSphereDataSource() datasource.DataSource {
+	return &CloudAccountVSphereDataSource{}
+}
+
+// CloudAccountVSphereDataSource defines the data source implementation.
+type CloudAccountVSphereDataSource struct {
+	client *AriaClient
+}
+
+func (self *CloudAccountVSphereDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_account_vsphere"
+}
+
+func (self *CloudAccountVSphereDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = CloudAccountVSphereDataSourceSchema()
+}
+
+func (self *CloudAccountVSphereDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self CloudAccountVSphereDataSource) ConfigValidators(
+	ctx context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (self *CloudAccountVSphereDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var account CloudAccountVSphereDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &account)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Lookup the cloud account by name (the filter may be case insensitive)
+	if len(account.Id.ValueString()) == 0 {
+		name := account.Name.ValueString()
+		accountRaw, err := FindOne(ctx, self.client, account.ListQuery(),
+			func(raw CloudAccountVSphereAPIModel) bool { return raw.Name == name })
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client error",
+				fmt.Sprintf("Unable to find %s, got error: %s", account.String(), err))
+			return
+		}
+		account.Id = types.StringValue(accountRaw.Id)
+	}
+
+	var accountFromAPI CloudAccountVSphereAPIModel
+	found, _, readDiags := self.client.ReadIt(
+		ctx, CloudAccountVSphereModel{CloudAccountVSphereDataSourceModel: account}, &accountFromAPI)
+	resp.Diagnostics.Append(readDiags...)
+	if !found {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to find %s, got error: not found", account.String()))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save cloud account into Terraform state
+	resp.Diagnostics.Append(account.FromAPI(ctx, accountFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &account)...)
+}
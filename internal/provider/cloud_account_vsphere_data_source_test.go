@@ -0,0 +1,59 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudAccountVSphereDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+variable "test_cloud_account_id" {
+  description = "vSphere cloud account to lookup."
+  type        = string
+}
+
+data "aria_cloud_account_vsphere" "by_id" {
+  id = var.test_cloud_account_id
+}
+
+data "aria_cloud_account_vsphere" "by_name" {
+  name = data.aria_cloud_account_vsphere.by_id.name
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aria_cloud_account_vsphere.by_id", "name"),
+					resource.TestCheckResourceAttrSet("data.aria_cloud_account_vsphere.by_id", "hostname"),
+					resource.TestCheckResourceAttrSet("data.aria_cloud_account_vsphere.by_id", "regions.#"),
+					resource.TestCheckResourceAttrSet("data.aria_cloud_account_vsphere.by_id", "org_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aria_cloud_account_vsphere.by_name", "id",
+						"data.aria_cloud_account_vsphere.by_id", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.aria_cloud_account_vsphere.by_name", "region_ids.%",
+						"data.aria_cloud_account_vsphere.by_id", "region_ids.%",
+					),
+				),
+			},
+			// Lookup failure testing
+			{
+				Config: `
+data "aria_cloud_account_vsphere" "missing" {
+  name = "NO-CLOUD-ACCOUNT-IS-NAMED-LIKE-THIS"
+}`,
+				ExpectError: regexp.MustCompile(
+					`no\s+instance\s+of\s+iaas/api/cloud-accounts-vsphere`),
+			},
+		},
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CloudAccountVSphereDataSourceModel describes the data source data model (the attributes of the
+// resource except the credentials).
+type CloudAccountVSphereDataSourceModel struct {
+	Id              types.String              `tfsdk:"id"`
+	Name            types.String              `tfsdk:"name"`
+	Description     types.String              `tfsdk:"description"`
+	Hostname        types.String              `tfsdk:"hostname"`
+	Username        types.String              `tfsdk:"username"`
+	DataCollectorId types.String              `tfsdk:"data_collector_id"`
+	Regions         []CloudAccountRegionModel `tfsdk:"regions"`
+	RegionIds       types.Map                 `tfsdk:"region_ids"`
+	Tags            []KeyValueTagModel        `tfsdk:"tags"`
+	OrgId           types.String              `tfsdk:"org_id"`
+}
+
+// CloudAccountVSphereModel describes the resource data model.
+type CloudAccountVSphereModel struct {
+	CloudAccountVSphereDataSourceModel
+
+	// Never returned by the API
+	Password                    types.String `tfsdk:"password"`
+	AcceptSelfSignedCertificate types.Bool   `tfsdk:"accept_self_signed_certificate"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// CloudAccountVSphereAPIModel describes the resource API model.
+type CloudAccountVSphereAPIModel struct {
+	Id                          string `json:"id,omitempty"`
+	Name                        string `json:"name"`
+	Description                 string `json:"description"`
+	Hostname                    string `json:"hostName"`
+	Username                    string `json:"username"`
+	Password                    string `json:"password,omitempty"`
+	AcceptSelfSignedCertificate bool   `json:"acceptSelfSignedCertificate"`
+	DataCollectorId             string `json:"dcid"`
+
+	// Regions are sent as regions and returned (with their identifier) as enabledRegions
+	Regions        []CloudAccountRegionAPIModel `json:"regions,omitempty"`
+	EnabledRegions []CloudAccountRegionAPIModel `json:"enabledRegions,omitempty"`
+
+	Tags  []KeyValueTagAPIModel `json:"tags"`
+	OrgId string                `json:"orgId,omitempty"`
+}
+
+func (self CloudAccountVSphereDataSourceModel) String() string {
+	return fmt.Sprintf(
+		"vSphere Cloud Account %s (%s)",
+		self.Id.ValueString(),
+		self.Name.ValueString())
+}
+
+func (self CloudAccountVSphereDataSourceModel) ListPath() string {
+	return "iaas/api/cloud-accounts-vsphere"
+}
+
+// Return the query retrieving the cloud accounts named like the cloud account to lookup.
+func (self CloudAccountVSphereDataSourceModel) ListQuery() ListQuery {
+	return ListQuery{
+		Path:       self.ListPath(),
+		Pagination: LIST_PAGINATION_ODATA,
+		Filter:     "name eq " + ODataString(self.Name.ValueString()),
+	}
+}
+
+func (self CloudAccountVSphereDataSourceModel) ReadPath() string {
+	return "iaas/api/cloud-accounts-vsphere/" + self.Id.ValueString()
+}
+
+// Return an appropriate key that can be used for naming mutexes.
+// Create: Identifier can be used to prevent concurrent creation of cloud accounts.
+// Read Update Delete: Identifier can be used to prevent concurrent modifications on the instance.
+func (self CloudAccountVSphereModel) LockKey() string {
+	return "cloud-account-" + self.Id.ValueString()
+}
+
+func (self CloudAccountVSphereModel) CreatePath() string {
+	return "iaas/api/cloud-accounts-vsphere"
+}
+
+func (self CloudAccountVSphereModel) UpdatePath() string {
+	return self.ReadPath()
+}
+
+func (self CloudAccountVSphereModel) DeletePath() string {
+	return self.ReadPath()
+}
+
+func (self *CloudAccountVSphereDataSourceModel) FromAPI(
+	ctx context.Context,
+	raw CloudAccountVSphereAPIModel,
+) diag.Diagnostics {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Description = types.StringValue(raw.Description)
+	self.Hostname = types.StringValue(raw.Hostname)
+	self.Username = types.StringValue(raw.Username)
+	self.DataCollectorId = types.StringValue(raw.DataCollectorId)
+	self.Tags = KeyValueTagsFromAPI(raw.Tags)
+	self.OrgId = types.StringValue(raw.OrgId)
+
+	self.Regions = []CloudAccountRegionModel{}
+	regionIds := map[string]string{}
+	for _, regionRaw := range raw.EnabledRegions {
+		region := CloudAccountRegionModel{}
+		region.FromAPI(regionRaw)
+		self.Regions = append(self.Regions, region)
+		regionIds[regionRaw.ExternalRegionId] = regionRaw.Id
+	}
+
+	var diags diag.Diagnostics
+	self.RegionIds, diags = types.MapValueFrom(ctx, types.StringType, regionIds)
+	return diags
+}
+
+func (self *CloudAccountVSphereModel) FromAPI(
+	ctx context.Context,
+	raw CloudAccountVSphereAPIModel,
+) diag.Diagnostics {
+	return self.CloudAccountVSphereDataSourceModel.FromAPI(ctx, raw)
+}
+
+func (self CloudAccountVSphereModel) ToAPI(
+	ctx context.Context,
+) (CloudAccountVSphereAPIModel, diag.Diagnostics) {
+	regionsRaw := []CloudAccountRegionAPIModel{}
+	for _, region := range self.Regions {
+		regionsRaw = append(regionsRaw, region.ToAPI())
+	}
+	return CloudAccountVSphereAPIModel{
+		Id:                          self.Id.ValueString(),
+		Name:                        self.Name.ValueString(),
+		Description:                 self.Description.ValueString(),
+		Hostname:                    self.Hostname.ValueString(),
+		Username:                    self.Username.ValueString(),
+		Password:                    self.Password.ValueString(),
+		AcceptSelfSignedCertificate: self.AcceptSelfSignedCertificate.ValueBool(),
+		DataCollectorId:             self.DataCollectorId.ValueString(),
+		Regions:                     regionsRaw,
+		Tags:                        KeyValueTagsToAPI(self.Tags),
+		OrgId:                       self.OrgId.ValueString(),
+	}, diag.Diagnostics{}
+}
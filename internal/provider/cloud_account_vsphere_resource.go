@@ -0,0 +1,283 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CloudAccountVSphereResource{}
+var _ ResourceWithSensitivePaths = &CloudAccountVSphereResource{}
+var _ resource.ResourceWithImportState = &CloudAccountVSphereResource{}
+
+func NewCloudAccountVSphereResource() resource.Resource {
+	return &CloudAccountVSphereResource{}
+}
+
+// CloudAccountVSphereResource defines the resource implementation.
+type CloudAccountVSphereResource struct {
+	client *AriaClient
+}
+
+func (self *CloudAccountVSphereResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_account_vsphere"
+}
+
+func (self *CloudAccountVSphereResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = CloudAccountVSphereSchema(ctx)
+}
+
+// Sensitive attributes are redacted from the bodies of the vSphere cloud accounts API.
+func (self *CloudAccountVSphereResource) SensitivePaths() []string {
+	return []string{CloudAccountVSphereModel{}.CreatePath()}
+}
+
+func (self *CloudAccountVSphereResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	self.client = GetResourceClient(ctx, req, resp)
+}
+
+func (self *CloudAccountVSphereResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	// Read Terraform plan data into the model
+	var account CloudAccountVSphereModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &account)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, timeoutDiags := account.Timeouts.Create(ctx, DEFAULT_CREATE_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	accountToAPI, diags := account.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The account is created asynchronously
+	var tracker RequestTrackerAPIModel
+	path := account.CreatePath()
+	response, err := self.client.R(ctx, path).SetBody(accountToAPI).SetResult(&tracker).Post(path)
+	err = self.client.HandleAPIResponse(response, err, []int{202})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to create %s, got error: %s", account.String(), err))
+		return
+	}
+
+	// Save the cloud account as soon as its identifier is known, so that it is tainted (instead
+	// of being orphaned) if the request is failing or timing out
+	if resourceId := tracker.ResourceId(); len(resourceId) > 0 {
+		account.Id = types.StringValue(resourceId)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &account)...)
+	}
+
+	tracker, diags = self.client.WaitRequestTracker(
+		ctx, tracker, fmt.Sprintf("creating %s", account.String()))
+	resp.Diagnostics.Append(diags...)
+	if resourceId := tracker.ResourceId(); len(resourceId) > 0 && account.Id.IsUnknown() {
+		account.Id = types.StringValue(resourceId)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &account)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if account.Id.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf(
+				"Unable to create %s, got error: request %s is not returning its identifier",
+				account.String(), tracker.Id))
+		return
+	}
+
+	resp.Diagnostics.Append(self.ReadAndSave(ctx, &account, &resp.State)...)
+	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", account.String()))
+}
+
+func (self *CloudAccountVSphereResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	// Read Terraform prior state data into the model
+	var account CloudAccountVSphereModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &account)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var accountFromAPI CloudAccountVSphereAPIModel
+	found, _, readDiags := self.client.ReadIt(ctx, &account, &accountFromAPI)
+	resp.Diagnostics.Append(readDiags...)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated cloud account into Terraform state
+	resp.Diagnostics.Append(account.FromAPI(ctx, accountFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &account)...)
+}
+
+func (self *CloudAccountVSphereResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	// Read Terraform plan data into the model
+	var account CloudAccountVSphereModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &account)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, timeoutDiags := account.Timeouts.Update(ctx, DEFAULT_UPDATE_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	accountToAPI, diags := account.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The account is updated asynchronously
+	var tracker RequestTrackerAPIModel
+	path := account.UpdatePath()
+	response, err := self.client.R(ctx, path).SetBody(accountToAPI).SetResult(&tracker).Patch(path)
+	err = self.client.HandleAPIResponse(response, err, []int{202})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to update %s, got error: %s", account.String(), err))
+		return
+	}
+
+	_, diags = self.client.WaitRequestTracker(
+		ctx, tracker, fmt.Sprintf("updating %s", account.String()))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(self.ReadAndSave(ctx, &account, &resp.State)...)
+	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", account.String()))
+}
+
+func (self *CloudAccountVSphereResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// Read Terraform prior state data into the model
+	var account CloudAccountVSphereModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &account)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, timeoutDiags := account.Timeouts.Delete(ctx, DEFAULT_DELETE_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// The account is deleted asynchronously
+	var tracker RequestTrackerAPIModel
+	path := account.DeletePath()
+	response, err := self.client.R(ctx, path).SetResult(&tracker).Delete(path)
+	err = self.client.HandleAPIResponse(response, err, []int{202})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to delete %s, got error: %s", account.String(), err))
+		return
+	}
+
+	_, diags := self.client.WaitRequestTracker(
+		ctx, tracker, fmt.Sprintf("deleting %s", account.String()))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (self *CloudAccountVSphereResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("accept_self_signed_certificate"), false)...)
+}
+
+// -------------------------------------------------------------------------------------------------
+
+// Read the cloud account (the request tracker is not returning it) then save it into the state.
+func (self *CloudAccountVSphereResource) ReadAndSave(
+	ctx context.Context,
+	account *CloudAccountVSphereModel,
+	state *tfsdk.State,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	var accountFromAPI CloudAccountVSphereAPIModel
+	found, _, readDiags := self.client.ReadIt(ctx, account, &accountFromAPI)
+	diags.Append(readDiags...)
+	if !found {
+		diags.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to read %s, got error: not found", account.String()))
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(account.FromAPI(ctx, accountFromAPI)...)
+	diags.Append(state.Set(ctx, account)...)
+	return diags
+}
@@ -0,0 +1,119 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccCloudAccountVSphereVariables = `
+variable "test_vsphere_hostname" {
+  description = "Host name of the vCenter."
+  type        = string
+}
+
+variable "test_vsphere_username" {
+  description = "Username to authenticate to the vCenter."
+  type        = string
+}
+
+variable "test_vsphere_password" {
+  description = "Password to authenticate to the vCenter."
+  type        = string
+  sensitive   = true
+}
+
+variable "test_vsphere_region" {
+  description = "Datacenter of the vCenter (external region identifier)."
+  type        = string
+}
+`
+
+func TestAccCloudAccountVSphereResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCloudAccountVSphereVariables + `
+resource "aria_cloud_account_vsphere" "test" {
+  name                           = "ARIA_PROVIDER_TEST_CLOUD_ACCOUNT"
+  hostname                       = var.test_vsphere_hostname
+  username                       = var.test_vsphere_username
+  password                       = var.test_vsphere_password
+  accept_self_signed_certificate = true
+
+  regions = [
+    {
+      external_region_id = var.test_vsphere_region
+      name               = "Datacenter"
+    }
+  ]
+}
+
+resource "aria_cloud_zone" "test" {
+  name      = "ARIA_PROVIDER_TEST_CLOUD_ACCOUNT_ZONE"
+  region_id = aria_cloud_account_vsphere.test.region_ids[var.test_vsphere_region]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aria_cloud_account_vsphere.test", "id"),
+					resource.TestCheckResourceAttr("aria_cloud_account_vsphere.test", "name", "ARIA_PROVIDER_TEST_CLOUD_ACCOUNT"),
+					resource.TestCheckResourceAttr("aria_cloud_account_vsphere.test", "description", ""),
+					resource.TestCheckResourceAttr("aria_cloud_account_vsphere.test", "data_collector_id", ""),
+					resource.TestCheckResourceAttr("aria_cloud_account_vsphere.test", "regions.#", "1"),
+					resource.TestCheckResourceAttr("aria_cloud_account_vsphere.test", "region_ids.%", "1"),
+					resource.TestCheckResourceAttr("aria_cloud_account_vsphere.test", "tags.#", "0"),
+					resource.TestCheckResourceAttrSet("aria_cloud_account_vsphere.test", "org_id"),
+					resource.TestCheckResourceAttrSet("aria_cloud_zone.test", "region_id"),
+				),
+			},
+			// Update testing (regions are unchanged, the zone is kept)
+			{
+				Config: testAccCloudAccountVSphereVariables + `
+resource "aria_cloud_account_vsphere" "test" {
+  name                           = "ARIA_PROVIDER_TEST_CLOUD_ACCOUNT"
+  description                    = "Cloud account managed by the acceptance tests."
+  hostname                       = var.test_vsphere_hostname
+  username                       = var.test_vsphere_username
+  password                       = var.test_vsphere_password
+  accept_self_signed_certificate = true
+
+  regions = [
+    {
+      external_region_id = var.test_vsphere_region
+      name               = "Datacenter"
+    }
+  ]
+
+  tags = [{ key = "env", value = "test" }]
+}
+
+resource "aria_cloud_zone" "test" {
+  name      = "ARIA_PROVIDER_TEST_CLOUD_ACCOUNT_ZONE"
+  region_id = aria_cloud_account_vsphere.test.region_ids[var.test_vsphere_region]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_cloud_account_vsphere.test", "description", "Cloud account managed by the acceptance tests."),
+					resource.TestCheckResourceAttr("aria_cloud_account_vsphere.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("aria_cloud_account_vsphere.test", "region_ids.%", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aria_cloud_account_vsphere.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"accept_self_signed_certificate", "password", "timeouts",
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
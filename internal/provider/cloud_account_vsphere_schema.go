@@ -0,0 +1,155 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	dataschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func CloudAccountVSphereSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "vSphere cloud account resource, the vCenter (and its datacenters) " +
+			"where the resources are provisioned",
+		Attributes: map[string]schema.Attribute{
+			"id": ComputedIdentifierSchema(""),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "A friendly name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Describe the resource in few sentences",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Host name (or IP address) of the vCenter",
+				Required:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username to authenticate to the vCenter",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password to authenticate to the vCenter (cannot be " +
+					"enforced since API don't return it)",
+				Required:  true,
+				Sensitive: true,
+			},
+			"accept_self_signed_certificate": schema.BoolAttribute{
+				MarkdownDescription: "Accept the certificate of the vCenter if self-signed " +
+					"(cannot be enforced since API don't return it), default is false",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"data_collector_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the data collector (cloud proxy) reaching " +
+					"the vCenter, default is empty (vCenter is directly reachable)",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"regions": schema.SetNestedAttribute{
+				MarkdownDescription: "Datacenters of the vCenter enabled for provisioning",
+				Required:            true,
+				NestedObject:        CloudAccountRegionSchema(),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"region_ids": schema.MapAttribute{
+				MarkdownDescription: "Identifier of the regions (e.g. to declare cloud zones), " +
+					"by external region identifier",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					UseStateForUnknownUnlessChanged(path.Root("regions")),
+				},
+			},
+			"tags":   KeyValueTagsSchema("Tags of the cloud account (default is none)"),
+			"org_id": ComputedOrganizationIdSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": TimeoutsSchema(ctx),
+		},
+	}
+}
+
+func CloudAccountRegionSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"external_region_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the datacenter in the vCenter " +
+					"(e.g. `Datacenter:datacenter-3`)",
+				Required: true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the datacenter",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func CloudAccountVSphereDataSourceSchema() dataschema.Schema {
+	return dataschema.Schema{
+		MarkdownDescription: "vSphere cloud account data source, lookup by identifier or name",
+		Attributes: map[string]dataschema.Attribute{
+			"id": OptionalIdentifierSchema(""),
+			"name": dataschema.StringAttribute{
+				MarkdownDescription: "Cloud account name (must match exactly one cloud account)",
+				Computed:            true,
+				Optional:            true,
+			},
+			"description": ComputedDescriptionSchema(),
+			"hostname": dataschema.StringAttribute{
+				MarkdownDescription: "Host name (or IP address) of the vCenter",
+				Computed:            true,
+			},
+			"username": dataschema.StringAttribute{
+				MarkdownDescription: "Username to authenticate to the vCenter",
+				Computed:            true,
+			},
+			"data_collector_id": dataschema.StringAttribute{
+				MarkdownDescription: "Identifier of the data collector (cloud proxy) reaching " +
+					"the vCenter",
+				Computed: true,
+			},
+			"regions": dataschema.SetNestedAttribute{
+				MarkdownDescription: "Datacenters of the vCenter enabled for provisioning",
+				Computed:            true,
+				NestedObject: dataschema.NestedAttributeObject{
+					Attributes: map[string]dataschema.Attribute{
+						"external_region_id": dataschema.StringAttribute{
+							MarkdownDescription: "Identifier of the datacenter in the vCenter",
+							Computed:            true,
+						},
+						"name": dataschema.StringAttribute{
+							MarkdownDescription: "Name of the datacenter",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"region_ids": dataschema.MapAttribute{
+				MarkdownDescription: "Identifier of the regions, by external region identifier",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"tags":   ComputedKeyValueTagsSchema("Tags of the cloud account"),
+			"org_id": ComputedOrganizationIdSchema(),
+		},
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CloudTemplateResourceMetadataModel describes the resource data model.
+type CloudTemplateResourceMetadataModel struct {
+	// Of type Int64
+	LayoutPosition types.List `tfsdk:"layout_position"`
+}
+
+// CloudTemplateResourceMetadataAPIModel describes the resource API model.
+type CloudTemplateResourceMetadataAPIModel struct {
+	LayoutPosition []int64 `yaml:"layoutPosition,omitempty"`
+}
+
+func (self CloudTemplateResourceMetadataModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"layout_position": types.ListType{ElemType: types.Int64Type},
+	}
+}
+
+func (self *CloudTemplateResourceMetadataModel) FromAPI(
+	ctx context.Context,
+	raw CloudTemplateResourceMetadataAPIModel,
+) diag.Diagnostics {
+	if raw.LayoutPosition == nil {
+		self.LayoutPosition = types.ListNull(types.Int64Type)
+		return diag.Diagnostics{}
+	}
+	var diags diag.Diagnostics
+	self.LayoutPosition, diags = types.ListValueFrom(ctx, types.Int64Type, raw.LayoutPosition)
+	return diags
+}
+
+func (self CloudTemplateResourceMetadataModel) ToAPI(
+	ctx context.Context,
+) (CloudTemplateResourceMetadataAPIModel, diag.Diagnostics) {
+	raw := CloudTemplateResourceMetadataAPIModel{}
+	diags := diag.Diagnostics{}
+	if !self.LayoutPosition.IsNull() && !self.LayoutPosition.IsUnknown() {
+		raw.LayoutPosition = []int64{}
+		diags = self.LayoutPosition.ElementsAs(ctx, &raw.LayoutPosition, false)
+	}
+	return raw, diags
+}
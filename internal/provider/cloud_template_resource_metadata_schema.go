@@ -0,0 +1,29 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func CloudTemplateResourceMetadataSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Resource metadata (e.g. its position on the design canvas)",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"layout_position": schema.ListAttribute{
+				MarkdownDescription: "Position of the resource on the design canvas (e.g. " +
+					"`[0, 1]`)",
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(2, 2),
+				},
+			},
+		},
+	}
+}
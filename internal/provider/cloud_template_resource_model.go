@@ -7,29 +7,37 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// CustomResourcPropertyeModel describes the resource data model.
+// CloudTemplateResourceModel describes the resource data model.
 type CloudTemplateResourceModel struct {
 	Name types.String `tfsdk:"name"`
 	Type types.String `tfsdk:"type"`
 
-	/*Metadata   CloudTemplateResourceMetadataModel   `json:"metadata"`*/
-	/*Properties CloudTemplateResourcePropertiesModel `json:"properties"`*/
+	Metadata *CloudTemplateResourceMetadataModel `tfsdk:"metadata"`
+
+	// Of type String
+	DependsOn types.List `tfsdk:"depends_on"`
 
 	AllocatePerInstance types.Bool `tfsdk:"allocate_per_instance"`
+
+	Properties jsontypes.Normalized `tfsdk:"properties"`
 }
 
 // CloudTemplateResourceAPIModel describes the resource API model.
 type CloudTemplateResourceAPIModel struct {
 	Type string `yaml:"type"`
 
-	/*Metadata   CloudTemplateResourceMetadataModel   `json:"metadata"`*/
-	/*Properties CloudTemplateResourcePropertiesModel `json:"properties"`*/
+	Metadata  *CloudTemplateResourceMetadataAPIModel `yaml:"metadata,omitempty"`
+	DependsOn []string                               `yaml:"dependsOn,omitempty"`
 
 	AllocatePerInstance *bool `yaml:"allocatePerInstance,omitempty"`
+
+	// Kept as is, including the expressions (e.g. ${input.flavor})
+	Properties any `yaml:"properties,omitempty"`
 }
 
 func (self CloudTemplateResourceModel) String() string {
@@ -47,11 +55,26 @@ func (self *CloudTemplateResourceModel) FromAPI(
 	self.Name = types.StringValue(name)
 	self.Type = types.StringValue(raw.Type)
 
-	/*self.Metadata =*/
-	/*self.Properties =*/
+	self.Metadata = nil
+	if raw.Metadata != nil {
+		self.Metadata = &CloudTemplateResourceMetadataModel{}
+		diags.Append(self.Metadata.FromAPI(ctx, *raw.Metadata)...)
+	}
+
+	if raw.DependsOn == nil {
+		self.DependsOn = types.ListNull(types.StringType)
+	} else {
+		var someDiags diag.Diagnostics
+		self.DependsOn, someDiags = types.ListValueFrom(ctx, types.StringType, raw.DependsOn)
+		diags.Append(someDiags...)
+	}
 
 	self.AllocatePerInstance = types.BoolPointerValue(raw.AllocatePerInstance)
 
+	var someDiags diag.Diagnostics
+	self.Properties, someDiags = JSONNormalizedFromAny(self.String(), raw.Properties)
+	diags.Append(someDiags...)
+
 	return diags
 }
 
@@ -61,12 +84,29 @@ func (self CloudTemplateResourceModel) ToAPI(
 
 	diags := diag.Diagnostics{}
 
+	var metadataRaw *CloudTemplateResourceMetadataAPIModel
+	if self.Metadata != nil {
+		raw, someDiags := self.Metadata.ToAPI(ctx)
+		metadataRaw = &raw
+		diags.Append(someDiags...)
+	}
+
+	var dependsOnRaw []string
+	if !self.DependsOn.IsNull() && !self.DependsOn.IsUnknown() {
+		dependsOnRaw = []string{}
+		diags.Append(self.DependsOn.ElementsAs(ctx, &dependsOnRaw, false)...)
+	}
+
+	propertiesRaw, someDiags := JSONNormalizedToAny(self.Properties)
+	diags.Append(someDiags...)
+
 	return self.Name.ValueString(),
 		CloudTemplateResourceAPIModel{
-			Type: self.Type.ValueString(),
-			/*Metadata:*/
-			/*Properties:*/
+			Type:                self.Type.ValueString(),
+			Metadata:            metadataRaw,
+			DependsOn:           dependsOnRaw,
 			AllocatePerInstance: self.AllocatePerInstance.ValueBoolPointer(),
+			Properties:          propertiesRaw,
 		},
 		diags
 }
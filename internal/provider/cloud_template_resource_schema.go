@@ -4,7 +4,11 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func CloudTemplateResourceSchema() schema.NestedAttributeObject {
@@ -18,14 +22,21 @@ func CloudTemplateResourceSchema() schema.NestedAttributeObject {
 				MarkdownDescription: "Resource type",
 				Required:            true,
 			},
-			/*"metadata": schema.MapNestedAttribute{
-				MarkdownDescription: "Resource metadata",
-				Required: true,
+			"metadata": CloudTemplateResourceMetadataSchema(),
+			"depends_on": schema.ListAttribute{
+				MarkdownDescription: "Resources (names) to provision before this one",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"properties": schema.StringAttribute{
+				MarkdownDescription: "Resource properties (JSON encoded), the expressions are " +
+					"kept as is (e.g. `\"$${input.flavor}\"` in HCL for `${input.flavor}`)",
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
 			},
-			"properties": schema.MapNestedAttribute{
-				MarkdownDescription: "Resource properties",
-				Required: true,
-			},*/
 			"allocate_per_instance": schema.BoolAttribute{
 				MarkdownDescription: "TODO",
 				Optional:            true,
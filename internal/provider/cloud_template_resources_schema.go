@@ -9,8 +9,8 @@ import (
 
 func CloudTemplateResourcesSchema() schema.MapNestedAttribute {
 	return schema.MapNestedAttribute{
-		MarkdownDescription: "Cloud Template's resources",
+		MarkdownDescription: "Cloud Template's resources (instead of `content`)",
 		NestedObject:        CloudTemplateResourceSchema(),
-		Required:            true,
+		Optional:            true,
 	}
 }
@@ -20,9 +20,12 @@ type CloudTemplateV1Model struct {
 	RequestScopeOrg types.Bool   `tfsdk:"request_scope_org"`
 	Status          types.String `tfsdk:"status"`
 
+	// Either the raw content or its inputs and resources
+	Content   YAMLNormalized              `tfsdk:"content"`
 	Inputs    UnorderedPropertiesModel    `tfsdk:"inputs"`
 	Resources CloudTemplateResourcesModel `tfsdk:"resources"`
 
+	ValidateOnPlan     types.Bool `tfsdk:"validate_on_plan"`
 	Valid              types.Bool `tfsdk:"valid"`
 	ValidationMessages types.List `tfsdk:"validation_messages"`
 	// Of type CloudTemplateV1ValidationMessageModel
@@ -93,16 +96,21 @@ func (self *CloudTemplateV1Model) FromAPI(
 	self.ProjectId = types.StringValue(raw.ProjectId)
 	self.OrgId = types.StringValue(raw.OrgId)
 
-	// Extract inputs and resources from raw content
-	var contentRaw CloudTemplateV1ContentAPIModel
-	err := yaml.Unmarshal([]byte(raw.Content), &contentRaw)
-	if err == nil {
-		diags.Append(self.Inputs.FromAPI(ctx, contentRaw.Inputs)...)
-		diags.Append(self.Resources.FromAPI(ctx, contentRaw.Resources)...)
+	if self.IsRawContent() {
+		// Content is kept as is (compared by its content, see YAMLNormalized)
+		self.Content = NewYAMLNormalizedValue(raw.Content)
 	} else {
-		diags.AddError(
-			"Configuration error",
-			fmt.Sprintf("Unable to YAML decode %s content", self.String()))
+		// Extract inputs and resources from raw content
+		var contentRaw CloudTemplateV1ContentAPIModel
+		err := yaml.Unmarshal([]byte(raw.Content), &contentRaw)
+		if err == nil {
+			diags.Append(self.Inputs.FromAPI(ctx, contentRaw.Inputs)...)
+			diags.Append(self.Resources.FromAPI(ctx, contentRaw.Resources)...)
+		} else {
+			diags.AddError(
+				"Configuration error",
+				fmt.Sprintf("Unable to YAML decode %s content", self.String()))
+		}
 	}
 
 	// Convert raw validation messages to a ListValue of objects
@@ -128,9 +136,16 @@ func (self *CloudTemplateV1Model) FromCreateAPI(raw CloudTemplateV1APIModel) {
 	// Projects and templates are not available
 }
 
-func (self CloudTemplateV1Model) ToAPI(
-	ctx context.Context,
-) (CloudTemplateV1APIModel, diag.Diagnostics) {
+// Return true if the content (YAML) is managed as is, instead of its inputs and resources.
+func (self CloudTemplateV1Model) IsRawContent() bool {
+	return !self.Content.IsNull()
+}
+
+// Return the content (YAML) of the cloud template, as is or with its inputs and resources.
+func (self CloudTemplateV1Model) ContentToAPI(ctx context.Context) (string, diag.Diagnostics) {
+	if self.IsRawContent() {
+		return self.Content.ValueString(), diag.Diagnostics{}
+	}
 
 	// Convert inputs and resources to raw content
 	inputsRaw, diags := self.Inputs.ToAPI(ctx)
@@ -149,6 +164,15 @@ func (self CloudTemplateV1Model) ToAPI(
 			fmt.Sprintf("Unable to YAML encode %s content", self.String()))
 	}
 
+	return string(contentRawBytes), diags
+}
+
+func (self CloudTemplateV1Model) ToAPI(
+	ctx context.Context,
+) (CloudTemplateV1APIModel, diag.Diagnostics) {
+
+	content, diags := self.ContentToAPI(ctx)
+
 	// Convert validation messages to raw API
 	/*messagesRaw := []CloudTemplateV1ValidationMessageAPIModel{}
 	for key, obj := range self.ValidationMessages {
@@ -161,7 +185,7 @@ func (self CloudTemplateV1Model) ToAPI(
 		Id:              self.Id.ValueString(),
 		Name:            self.Name.ValueString(),
 		Description:     CleanString(self.Description.ValueString()),
-		Content:         string(contentRawBytes),
+		Content:         content,
 		RequestScopeOrg: self.RequestScopeOrg.ValueBool(),
 		Status:          self.Status.ValueString(),
 		Valid:           self.Valid.ValueBool(),
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -14,7 +15,9 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CloudTemplateV1Resource{}
+var _ resource.ResourceWithConfigValidators = &CloudTemplateV1Resource{}
 var _ resource.ResourceWithImportState = &CloudTemplateV1Resource{}
+var _ resource.ResourceWithModifyPlan = &CloudTemplateV1Resource{}
 
 func NewCloudTemplateV1Resource() resource.Resource {
 	return &CloudTemplateV1Resource{}
@@ -49,6 +52,77 @@ func (self *CloudTemplateV1Resource) Configure(
 	self.client = GetResourceClient(ctx, req, resp)
 }
 
+func (self CloudTemplateV1Resource) ConfigValidators(
+	ctx context.Context,
+) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("content"),
+			path.MatchRoot("resources"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("inputs"),
+			path.MatchRoot("resources"),
+		),
+	}
+}
+
+// Validate the content while planning (if requested), the errors are failing the plan.
+func (self *CloudTemplateV1Resource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	// Nothing to validate when destroying or if the provider is not configured (yet)
+	if req.Plan.Raw.IsNull() || self.client == nil {
+		return
+	}
+
+	// Content cannot be validated until known and is not validated again if unchanged (unless
+	// validation has just been enabled)
+	unchanged := !req.State.Raw.IsNull()
+	for _, name := range []string{
+		"content", "inputs", "resources", "project_id", "validate_on_plan",
+	} {
+		planned := GetRawAttribute(req.Plan.Raw, name)
+		if !planned.IsFullyKnown() {
+			return
+		}
+		unchanged = unchanged && planned.Equal(GetRawAttribute(req.State.Raw, name))
+	}
+	if unchanged {
+		return
+	}
+
+	var template CloudTemplateV1Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &template)...)
+	if resp.Diagnostics.HasError() || !template.ValidateOnPlan.ValueBool() {
+		return
+	}
+
+	content, diags := template.ContentToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var resultFromAPI CloudTemplateV1ValidationResultAPIModel
+	path := template.ValidatePath()
+	response, err := self.client.R(ctx, path).
+		SetBody(template.ToValidationAPI(content)).
+		SetResult(&resultFromAPI).
+		Post(path)
+	err = self.client.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to validate %s, got error: %s", template.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(template.ValidationDiagnostics(resultFromAPI)...)
+}
+
 func (self *CloudTemplateV1Resource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -69,7 +143,7 @@ func (self *CloudTemplateV1Resource) Create(
 
 	var templateFromAPI CloudTemplateV1APIModel
 	path := template.CreatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetBody(templateToAPI).
 		SetResult(&templateFromAPI).
 		Post(path)
@@ -86,7 +160,7 @@ func (self *CloudTemplateV1Resource) Create(
 
 	// Read (using API) to retrieve the projects & templates (and counters)
 	path = template.ReadPath()
-	response, err = self.client.R(path).SetResult(&templateFromAPI).Get(path)
+	response, err = self.client.R(ctx, path).SetResult(&templateFromAPI).Get(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -114,7 +188,7 @@ func (self *CloudTemplateV1Resource) Read(
 	}
 
 	var templateRaw CloudTemplateV1APIModel
-	found, _, readDiags := self.client.ReadIt(&template, &templateRaw)
+	found, _, readDiags := self.client.ReadIt(ctx, &template, &templateRaw)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -150,7 +224,7 @@ func (self *CloudTemplateV1Resource) Update(
 
 	var templateFromAPI CloudTemplateV1APIModel
 	path := template.UpdatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetBody(templateToAPI).
 		SetResult(&templateFromAPI).
 		Put(path)
@@ -177,7 +251,7 @@ func (self *CloudTemplateV1Resource) Delete(
 	var template CloudTemplateV1Model
 	resp.Diagnostics.Append(req.State.Get(ctx, &template)...)
 	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&template)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &template)...)
 	}
 }
 
@@ -188,4 +262,5 @@ func (self *CloudTemplateV1Resource) ImportState(
 ) {
 	// FIXME must be filtered by id and projectId
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("validate_on_plan"), false)...)
 }
@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -137,13 +138,7 @@ resource "aria_cloud_template_v1" "test" {
       name = "Network_1"
       type = "Cloud.vSphere.Network"
       metadata = {
-        layoutPosition = [1, 0]
-      }
-      properties = {
-        networkType = "existing"
-        constraints = [
-          { tag = "subnet_id:1234" }
-        ]
+        layout_position = [1, 0]
       }
     }
   }
@@ -155,6 +150,7 @@ resource "aria_cloud_template_v1" "test" {
 					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "description", "Temporary cloud template generated by Aria provider's acceptance tests."),
 					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "request_scope_org", "true"),
 					resource.TestCheckResourceAttrSet("aria_cloud_template_v1.test", "org_id"),
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "validate_on_plan", "false"),
 					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "valid", "false"),
 					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "validation_messages.0.resource_name", "Network_1"),
 					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "validation_messages.0.path", "$.resources.Network_1"),
@@ -163,6 +159,167 @@ resource "aria_cloud_template_v1" "test" {
 					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "validation_messages.0.message", "Resource properties is mandatory"),
 				),
 			},
+			// Update testing (resources with properties, including expressions)
+			{
+				Config: `
+variable "test_project_id" {
+  description = "Project where to generate test resources."
+  type        = string
+}
+
+locals {
+  windows_flavor_mappings = {
+    "Windows-S" = {
+      cpu_count = 2
+      memory    = 8192
+    }
+    "Windows-M" = {
+      cpu_count = 4
+      memory    = 16384
+    }
+    "Windows-L" = {
+      cpu_count = 8
+      memory    = 32768
+    }
+  }
+
+  cloud_config = <<-EOT
+    #cloud-config
+    write_files:
+      - content: This is a good test....
+        path: C:\SM\config_done.txt
+      - content: |
+          $disks = Get-Disk | Where-Object PartitionStyle -Eq "RAW";
+          ForEach ($disk in $disks) {$disk | Initialize-Disk -PassThru | New-Partition -AssignDriveLetter -UseMaximumSize | Format-Volume}
+        path: C:\SM\disk.ps1
+      - content: |
+          Set-Location "C:\Program Files\VMware\VMware Tools\";
+          .\rpctool.exe "info-set guestinfo.userdata  "
+        path: C:\SM\guestinfo.ps1
+    set_timezone: Europe/Zurich
+    ntp:
+      enabled: true
+      servers: ['ntp.domain.net,0x8 ntp.domain.net,0x8']
+    runcmd:
+      - 'PowerShell -NoProfile -ExecutionPolicy Bypass -Command "& {Start-Process PowerShell -ArgumentList (Set-ExecutionPolicy Unrestricted -Force) -Verb RunAs}"'
+      - 'PowerShell Set-LocalUser Administrator -PasswordNeverExpires $true'
+      - 'PowerShell C:\\SM\\disk.ps1'
+      - 'PowerShell set-service -name cloudbase-init -StartupType Disabled'
+      - 'PowerShell setx BUILD_CB OK /M'
+      - 'PowerShell C:\\SM\\guestinfo.ps1'
+      - 'PowerShell start-sleep 2'
+      - 'PowerShell Restart-Computer -Force'
+  EOT
+}
+
+resource "aria_cloud_template_v1" "test" {
+  name              = "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE"
+  description       = "Temporary cloud template generated by Aria provider's acceptance tests."
+  project_id        = var.test_project_id
+  request_scope_org = true
+
+  inputs = {
+
+    disks = {
+      name               = "disks"
+      description        = "Disks"
+      title              = "Disks"
+      type               = "array"
+      encrypted          = false
+      read_only          = false
+      recreate_on_update = false
+      items = {
+        title = "Disque"
+        type  = "object"
+        properties = {
+          size = {
+            type  = "integer"
+            title = "Disk size in GB"
+          }
+          lunId = {
+            type = "integer"
+          }
+        }
+      }
+    }
+
+    flavor = {
+      name               = "flavor"
+      description        = "Flavor"
+      title              = "Flavor"
+      type               = "string"
+      default            = jsonencode("Windows-S")
+      encrypted          = false
+      read_only          = false
+      recreate_on_update = false
+      oneOf = [
+        for name, specs in local.windows_flavor_mappings :
+        {
+          title     = "${name} (${specs.cpu_count} vCPU, ${specs.memory / 1024} Go RAM)"
+          const     = name
+          encrypted = false
+        }
+      ]
+    }
+
+    /* vm_common = var.vm_common_property_group.as_property */
+
+    install_iis = {
+      name               = "install_iis"
+      description        = "Install IIS?"
+      title              = "IIS"
+      type               = "boolean"
+      default            = false
+      encrypted          = false
+      read_only          = false
+      recreate_on_update = false
+    }
+
+  }
+
+  resources = {
+    Network_1 = {
+      name = "Network_1"
+      type = "Cloud.vSphere.Network"
+      metadata = {
+        layout_position = [1, 0]
+      }
+      properties = jsonencode({
+        networkType = "existing"
+        constraints = [
+          { tag = "subnet_id:1234" }
+        ]
+      })
+    }
+    Machine_1 = {
+      name       = "Machine_1"
+      type       = "Cloud.vSphere.Machine"
+      depends_on = ["Network_1"]
+      metadata = {
+        layout_position = [0, 0]
+      }
+      properties = jsonencode({
+        image       = "windows-2022"
+        flavor      = "$${input.flavor}"
+        cloudConfig = "$${input.install_iis ? 'iis' : 'none'}"
+        networks = [
+          { network = "$${resource.Network_1.id}", assignment = "static" }
+        ]
+        disks = "$${map_by(input.disks, disk => { 'size': disk.size })}"
+      })
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "validate_on_plan", "false"),
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "valid", "true"),
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "validation_messages.#", "0"),
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "resources.Network_1.metadata.layout_position.0", "1"),
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "resources.Machine_1.depends_on.0", "Network_1"),
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "resources.Machine_1.properties", `{"cloudConfig":"${input.install_iis ? 'iis' : 'none'}","disks":"${map_by(input.disks, disk =\u003e { 'size': disk.size })}","flavor":"${input.flavor}","image":"windows-2022","networks":[{"assignment":"static","network":"${resource.Network_1.id}"}]}`),
+				),
+			},
 			// ImportState testing
 			/* TODO https://github.com/davidfischer-ch/terraform-provider-aria/issues/33*/
 			{
@@ -174,3 +331,338 @@ resource "aria_cloud_template_v1" "test" {
 		},
 	})
 }
+
+func TestAccCloudTemplateV1Resource_Content(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+variable "test_project_id" {
+  description = "Project where to generate test resources."
+  type        = string
+}
+
+resource "aria_cloud_template_v1" "test" {
+  name              = "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE_CONTENT"
+  description       = "Temporary cloud template generated by Aria provider's acceptance tests."
+  project_id        = var.test_project_id
+  request_scope_org = false
+
+  content = <<-EOT
+    formatVersion: 1
+    inputs:
+      flavor:
+        type: string
+        title: Flavor
+        enum: [small, large]
+        default: small
+    resources:
+      Machine_1:
+        type: Cloud.vSphere.Machine
+        properties:
+          image: ubuntu
+          flavor: $${input.flavor}
+          count: '$${input.flavor == "large" ? 2 : 1}'
+    outputs:
+      address:
+        value: $${resource.Machine_1.address}
+  EOT
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aria_cloud_template_v1.test", "id"),
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "name", "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE_CONTENT"),
+					resource.TestMatchResourceAttr("aria_cloud_template_v1.test", "content", regexp.MustCompile(`flavor: \$\{input\.flavor\}`)),
+					resource.TestCheckNoResourceAttr("aria_cloud_template_v1.test", "inputs"),
+					resource.TestCheckNoResourceAttr("aria_cloud_template_v1.test", "resources"),
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "valid", "true"),
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "validation_messages.#", "0"),
+				),
+			},
+			// Update testing (same content formatted differently)
+			{
+				Config: `
+variable "test_project_id" {
+  description = "Project where to generate test resources."
+  type        = string
+}
+
+resource "aria_cloud_template_v1" "test" {
+  name              = "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE_CONTENT"
+  description       = "Temporary cloud template generated by Aria provider's acceptance tests."
+  project_id        = var.test_project_id
+  request_scope_org = false
+
+  content = <<-EOT
+    # Reformatted by hand
+    formatVersion: 1
+    outputs: {address: {value: '$${resource.Machine_1.address}'}}
+    resources:
+      Machine_1:
+        properties:
+          count: '$${input.flavor == "large" ? 2 : 1}'
+          flavor: "$${input.flavor}"
+          image: ubuntu
+        type: Cloud.vSphere.Machine
+    inputs:
+      flavor:
+        default: small
+        enum:
+          - small
+          - large
+        title: Flavor
+        type: string
+  EOT
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("aria_cloud_template_v1.test", "content", regexp.MustCompile(`# Reformatted by hand`)),
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "valid", "true"),
+				),
+			},
+			// Update testing (a resource without properties is reported)
+			{
+				Config: `
+variable "test_project_id" {
+  description = "Project where to generate test resources."
+  type        = string
+}
+
+resource "aria_cloud_template_v1" "test" {
+  name              = "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE_CONTENT"
+  description       = "Temporary cloud template generated by Aria provider's acceptance tests."
+  project_id        = var.test_project_id
+  request_scope_org = false
+
+  content = <<-EOT
+    formatVersion: 1
+    inputs: {}
+    resources:
+      Network_1:
+        type: Cloud.vSphere.Network
+  EOT
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "valid", "false"),
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "validation_messages.0.resource_name", "Network_1"),
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "validation_messages.0.message", "Resource properties is mandatory"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCloudTemplateV1Resource_ContentErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid YAML
+			{
+				Config: `
+resource "aria_cloud_template_v1" "test" {
+  name              = "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE_CONTENT"
+  description       = ""
+  project_id        = "some-project"
+  request_scope_org = false
+  content           = "resources: ["
+}
+`,
+				ExpectError: regexp.MustCompile(`Unable\s+to\s+YAML\s+decode\s+the\s+value`),
+			},
+			// Content and resources are mutually exclusive
+			{
+				Config: `
+resource "aria_cloud_template_v1" "test" {
+  name              = "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE_CONTENT"
+  description       = ""
+  project_id        = "some-project"
+  request_scope_org = false
+  content           = "resources: {}"
+  inputs            = {}
+  resources         = {}
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid\s+Attribute\s+Combination`),
+			},
+		},
+	})
+}
+
+func TestAccCloudTemplateV1Resource_PlanValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Resource without properties
+			{
+				Config: `
+variable "test_project_id" {
+  description = "Project where to generate test resources."
+  type        = string
+}
+
+resource "aria_cloud_template_v1" "test" {
+  name              = "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE_PLAN_VALIDATION"
+  description       = "Temporary cloud template generated by Aria provider's acceptance tests."
+  project_id        = var.test_project_id
+  request_scope_org = false
+  validate_on_plan  = true
+
+  inputs = {}
+
+  resources = {
+    Network_1 = {
+      name = "Network_1"
+      type = "Cloud.vSphere.Network"
+    }
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Resource\s+properties\s+is\s+mandatory\s+\(at\s+\$\.resources\.Network_1\)`),
+			},
+			// Input with a default value not allowed
+			{
+				Config: `
+variable "test_project_id" {
+  description = "Project where to generate test resources."
+  type        = string
+}
+
+resource "aria_cloud_template_v1" "test" {
+  name              = "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE_PLAN_VALIDATION"
+  description       = "Temporary cloud template generated by Aria provider's acceptance tests."
+  project_id        = var.test_project_id
+  request_scope_org = false
+  validate_on_plan  = true
+
+  content = <<-EOT
+    inputs:
+      flavor:
+        type: string
+        enum: [small, large]
+        default: medium
+    resources:
+      Machine_1:
+        type: Cloud.vSphere.Machine
+        properties:
+          flavor: $${input.flavor}
+  EOT
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Default\s+value\s+medium\s+is\s+not\s+one\s+of\s+\[small\s+large\]`),
+			},
+			// Content depending on another resource is validated once known
+			{
+				Config: `
+variable "test_project_id" {
+  description = "Project where to generate test resources."
+  type        = string
+}
+
+resource "aria_property_group" "test" {
+  name        = "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE_PLAN_VALIDATION"
+  description = "Temporary property group generated by Aria provider's acceptance tests."
+  type        = "INPUT"
+  properties  = {}
+}
+
+resource "aria_cloud_template_v1" "test" {
+  name              = "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE_PLAN_VALIDATION"
+  description       = "Temporary cloud template generated by Aria provider's acceptance tests."
+  project_id        = var.test_project_id
+  request_scope_org = false
+  validate_on_plan  = true
+
+  content = <<-EOT
+    resources:
+      Machine_1:
+        type: Cloud.vSphere.Machine
+        properties:
+          image: ubuntu
+          propertyGroupId: ${aria_property_group.test.id}
+  EOT
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "valid", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCloudTemplateV1Resource_PlanValidationOptIn(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid draft is saved (validation is opt-in)
+			{
+				Config: `
+variable "test_project_id" {
+  description = "Project where to generate test resources."
+  type        = string
+}
+
+resource "aria_cloud_template_v1" "test" {
+  name              = "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE_PLAN_VALIDATION_OPT_IN"
+  description       = "Temporary cloud template generated by Aria provider's acceptance tests."
+  project_id        = var.test_project_id
+  request_scope_org = false
+
+  inputs = {}
+
+  resources = {
+    Network_1 = {
+      name = "Network_1"
+      type = "Cloud.vSphere.Network"
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "validate_on_plan", "false"),
+					resource.TestCheckResourceAttr("aria_cloud_template_v1.test", "valid", "false"),
+				),
+			},
+			// Unchanged content is validated as soon as validation is enabled
+			{
+				Config: `
+variable "test_project_id" {
+  description = "Project where to generate test resources."
+  type        = string
+}
+
+resource "aria_cloud_template_v1" "test" {
+  name              = "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE_PLAN_VALIDATION_OPT_IN"
+  description       = "Temporary cloud template generated by Aria provider's acceptance tests."
+  project_id        = var.test_project_id
+  request_scope_org = false
+  validate_on_plan  = true
+
+  inputs = {}
+
+  resources = {
+    Network_1 = {
+      name = "Network_1"
+      type = "Cloud.vSphere.Network"
+    }
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Resource\s+properties\s+is\s+mandatory\s+\(at\s+\$\.resources\.Network_1\)`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
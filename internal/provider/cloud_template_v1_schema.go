@@ -5,9 +5,15 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 )
 
 func CloudTemplateV1Schema() schema.Schema {
+	// Either content or inputs and resources are declared (see ConfigValidators)
+	inputs := UnorderedPropertiesSchema("Cloud Template's properties (required with `resources`)")
+	inputs.Required = false
+	inputs.Optional = true
+
 	return schema.Schema{
 		MarkdownDescription: "Cloud Template (v1 format) resource (WORK IN PROGRESS, DO NOT USE)",
 		Attributes: map[string]schema.Attribute{
@@ -22,7 +28,16 @@ func CloudTemplateV1Schema() schema.Schema {
 				MarkdownDescription: "Requestable from any project in organization?",
 				Required:            true,
 			},
-			"inputs":    UnorderedPropertiesSchema("Cloud Template's properties"),
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Cloud Template's content (YAML) declared as is, e.g. with " +
+					"`formatVersion` and `outputs` (instead of `inputs` and `resources`). " +
+					"The content read from Aria is compared to the state as YAML documents " +
+					"(formatting and comments are ignored, no drift). Imported cloud templates " +
+					"are read with their `inputs` and `resources`.",
+				CustomType: YAMLNormalizedType{},
+				Optional:   true,
+			},
+			"inputs":    inputs,
 			"resources": CloudTemplateResourcesSchema(),
 			"status": schema.StringAttribute{
 				MarkdownDescription: "Status",
@@ -32,6 +47,15 @@ func CloudTemplateV1Schema() schema.Schema {
 				MarkdownDescription: "Cloud Template validation result",
 				Computed:            true,
 			},
+			"validate_on_plan": schema.BoolAttribute{
+				MarkdownDescription: "Validate the content while planning, the errors are " +
+					"reported on the matching input or resource and are failing the plan " +
+					"(opt-in, default is false so invalid drafts are saved and planning makes no " +
+					"additional API call, the content is validated as soon as it is set to true)",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"validation_messages": schema.ListNestedAttribute{
 				MarkdownDescription: "Cloud Template validation (error) messages.",
 				Computed:            true,
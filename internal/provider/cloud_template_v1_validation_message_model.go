@@ -24,6 +24,7 @@ type CloudTemplateV1ValidationMessageAPIModel struct {
 	ResourceName string `json:"resourceName,omitempty"`
 	Path         string `json:"path"`
 	Message      string `json:"message"`
+	Type         string `json:"type,omitempty"`
 }
 
 func (self CloudTemplateV1ValidationMessageModel) String() string {
@@ -0,0 +1,114 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Type of the validation messages that are not failing the validation.
+const CLOUD_TEMPLATE_VALIDATION_WARNING = "WARNING"
+const CLOUD_TEMPLATE_VALIDATION_INFO = "INFO"
+
+// CloudTemplateV1ValidationAPIModel describes the request validating a content.
+type CloudTemplateV1ValidationAPIModel struct {
+	Content   string `json:"content"`
+	ProjectId string `json:"projectId"`
+}
+
+// CloudTemplateV1ValidationResultAPIModel describes the result of the validation.
+type CloudTemplateV1ValidationResultAPIModel struct {
+	Valid              bool                                       `json:"valid"`
+	ValidationMessages []CloudTemplateV1ValidationMessageAPIModel `json:"validationMessages"`
+}
+
+func (self CloudTemplateV1Model) ValidatePath() string {
+	return "blueprint/api/blueprint-validation"
+}
+
+func (self CloudTemplateV1Model) ToValidationAPI(content string) CloudTemplateV1ValidationAPIModel {
+	return CloudTemplateV1ValidationAPIModel{
+		Content:   content,
+		ProjectId: self.ProjectId.ValueString(),
+	}
+}
+
+// Convert the validation messages to diagnostics pointing at the matching input or resource
+// (or at the content). Warnings and informations are not failing the plan.
+func (self CloudTemplateV1Model) ValidationDiagnostics(
+	raw CloudTemplateV1ValidationResultAPIModel,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	for _, message := range raw.ValidationMessages {
+		attributePath := self.ValidationMessagePath(message)
+		detail := message.Message
+		if len(message.Path) > 0 {
+			detail = fmt.Sprintf("%s (at %s)", detail, message.Path)
+		}
+		switch message.Type {
+		case CLOUD_TEMPLATE_VALIDATION_WARNING, CLOUD_TEMPLATE_VALIDATION_INFO:
+			diags.AddAttributeWarning(attributePath, "Cloud template validation", detail)
+		default:
+			diags.AddAttributeError(
+				attributePath,
+				"Invalid cloud template",
+				fmt.Sprintf("%s is invalid: %s", self.String(), detail))
+		}
+	}
+	if !raw.Valid && !diags.HasError() {
+		diags.AddAttributeError(
+			self.ContentPath(),
+			"Invalid cloud template",
+			fmt.Sprintf("%s is invalid (no validation message)", self.String()))
+	}
+	return diags
+}
+
+// Return the path of the attribute matching the validation message, the input or resource
+// (e.g. $.inputs.flavor.default or resource Machine_1) if declared as such else the content.
+func (self CloudTemplateV1Model) ValidationMessagePath(
+	raw CloudTemplateV1ValidationMessageAPIModel,
+) path.Path {
+	if self.IsRawContent() {
+		return path.Root("content")
+	}
+
+	if _, found := self.Resources[raw.ResourceName]; found {
+		return path.Root("resources").AtMapKey(raw.ResourceName)
+	}
+	if name := ValidationMessagePathName(raw.Path, "inputs"); len(name) > 0 {
+		if _, found := self.Inputs[name]; found {
+			return path.Root("inputs").AtMapKey(name)
+		}
+	}
+	if name := ValidationMessagePathName(raw.Path, "resources"); len(name) > 0 {
+		if _, found := self.Resources[name]; found {
+			return path.Root("resources").AtMapKey(name)
+		}
+	}
+	return self.ContentPath()
+}
+
+// Return the name of the input or resource (by key) targeted by the path of a validation message
+// (e.g. flavor for $.inputs.flavor.default and key inputs), empty if not targeting any of those.
+func ValidationMessagePathName(messagePath string, key string) string {
+	name, found := strings.CutPrefix(messagePath, "$."+key+".")
+	if !found {
+		return ""
+	}
+	name, _, _ = strings.Cut(name, ".")
+	return name
+}
+
+// Return the path of the attribute(s) holding the content, resources if not declared as is.
+func (self CloudTemplateV1Model) ContentPath() path.Path {
+	if self.IsRawContent() {
+		return path.Root("content")
+	}
+	return path.Root("resources")
+}
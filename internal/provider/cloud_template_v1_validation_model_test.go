@@ -0,0 +1,115 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestCloudTemplateV1Model_ValidationDiagnostics(t *testing.T) {
+	template := CloudTemplateV1Model{
+		Inputs:    UnorderedPropertiesModel{"flavor": PropertyModel{}},
+		Resources: CloudTemplateResourcesModel{"Machine_1": CloudTemplateResourceModel{}},
+	}
+	templateContent := CloudTemplateV1Model{Content: NewYAMLNormalizedValue("")}
+
+	cases := []struct {
+		name           string
+		template       CloudTemplateV1Model
+		message        CloudTemplateV1ValidationMessageAPIModel
+		attributePath  path.Path
+		warningMessage string
+		errorMessage   string
+	}{
+		{
+			name:     "resource (by name)",
+			template: template,
+			message: CloudTemplateV1ValidationMessageAPIModel{
+				ResourceName: "Machine_1",
+				Path:         "$.resources.Machine_1",
+				Message:      "Resource properties is mandatory",
+			},
+			attributePath: path.Root("resources").AtMapKey("Machine_1"),
+			errorMessage:  "Resource properties is mandatory (at $.resources.Machine_1)",
+		},
+		{
+			name:     "resource (by path)",
+			template: template,
+			message: CloudTemplateV1ValidationMessageAPIModel{
+				Path:    "$.resources.Machine_1.properties.flavor",
+				Message: "Unknown input",
+				Type:    "ERROR",
+			},
+			attributePath: path.Root("resources").AtMapKey("Machine_1"),
+			errorMessage:  "Unknown input (at $.resources.Machine_1.properties.flavor)",
+		},
+		{
+			name:     "input",
+			template: template,
+			message: CloudTemplateV1ValidationMessageAPIModel{
+				Path:    "$.inputs.flavor.default",
+				Message: "Default value is not allowed",
+			},
+			attributePath: path.Root("inputs").AtMapKey("flavor"),
+			errorMessage:  "Default value is not allowed (at $.inputs.flavor.default)",
+		},
+		{
+			name:     "unknown input",
+			template: template,
+			message: CloudTemplateV1ValidationMessageAPIModel{
+				Path:    "$.inputs.count",
+				Message: "Some error",
+			},
+			attributePath: path.Root("resources"),
+			errorMessage:  "Some error (at $.inputs.count)",
+		},
+		{
+			name:     "warning",
+			template: template,
+			message: CloudTemplateV1ValidationMessageAPIModel{
+				ResourceName: "Machine_1",
+				Message:      "Property is deprecated",
+				Type:         "WARNING",
+			},
+			attributePath:  path.Root("resources").AtMapKey("Machine_1"),
+			warningMessage: "Property is deprecated",
+		},
+		{
+			name:     "content",
+			template: templateContent,
+			message: CloudTemplateV1ValidationMessageAPIModel{
+				ResourceName: "Machine_1",
+				Path:         "$.resources.Machine_1",
+				Message:      "Resource properties is mandatory",
+			},
+			attributePath: path.Root("content"),
+			errorMessage:  "Resource properties is mandatory (at $.resources.Machine_1)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := tc.template.ValidationDiagnostics(CloudTemplateV1ValidationResultAPIModel{
+				Valid:              len(tc.errorMessage) == 0,
+				ValidationMessages: []CloudTemplateV1ValidationMessageAPIModel{tc.message},
+			})
+			CheckDiagnostics(t, diags, tc.warningMessage, tc.errorMessage)
+			for _, diagnostic := range diags {
+				withPath, ok := diagnostic.(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(tc.attributePath) {
+					t.Errorf("Expected diagnostic on %s, got %v", tc.attributePath, diagnostic)
+				}
+			}
+		})
+	}
+}
+
+func TestCloudTemplateV1Model_ValidationDiagnostics_NoMessage(t *testing.T) {
+	template := CloudTemplateV1Model{Resources: CloudTemplateResourcesModel{}}
+	diags := template.ValidationDiagnostics(CloudTemplateV1ValidationResultAPIModel{Valid: false})
+	CheckDiagnostics(t, diags, "", "is invalid (no validation message)")
+}
@@ -0,0 +1,153 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Status of the versions of a cloud template.
+const CLOUD_TEMPLATE_VERSION_VERSIONED = "VERSIONED"
+const CLOUD_TEMPLATE_VERSION_RELEASED = "RELEASED"
+
+// CloudTemplateVersionModel describes the resource data model.
+type CloudTemplateVersionModel struct {
+	Id              types.String `tfsdk:"id"`
+	CloudTemplateId types.String `tfsdk:"cloud_template_id"`
+	Version         types.String `tfsdk:"version"`
+	Description     types.String `tfsdk:"description"`
+	ChangeLog       types.String `tfsdk:"change_log"`
+	Release         types.Bool   `tfsdk:"release"`
+
+	Name    types.String `tfsdk:"name"`
+	Status  types.String `tfsdk:"status"`
+	Content types.String `tfsdk:"content"`
+	Valid   types.Bool   `tfsdk:"valid"`
+
+	CreatedAt timetypes.RFC3339 `tfsdk:"created_at"`
+	CreatedBy types.String      `tfsdk:"created_by"`
+
+	ProjectId types.String `tfsdk:"project_id"`
+	OrgId     types.String `tfsdk:"org_id"`
+}
+
+// CloudTemplateVersionAPIModel describes the resource API model.
+type CloudTemplateVersionAPIModel struct {
+	Id                 string `json:"id"`
+	BlueprintId        string `json:"blueprintId"`
+	Name               string `json:"name"`
+	Version            string `json:"version"`
+	VersionDescription string `json:"versionDescription"`
+	VersionChangeLog   string `json:"versionChangeLog"`
+	Status             string `json:"status"`
+	Content            string `json:"content"`
+	Valid              bool   `json:"valid"`
+
+	CreatedAt string `json:"createdAt"`
+	CreatedBy string `json:"createdBy"`
+
+	ProjectId string `json:"projectId"`
+	OrgId     string `json:"orgId"`
+}
+
+// CloudTemplateVersionRequestAPIModel describes the request creating a version.
+type CloudTemplateVersionRequestAPIModel struct {
+	Version     string `json:"version"`
+	Description string `json:"description"`
+	ChangeLog   string `json:"changeLog"`
+	Release     bool   `json:"release"`
+}
+
+func (self CloudTemplateVersionModel) String() string {
+	return fmt.Sprintf(
+		"Cloud Template %s Version %s",
+		self.CloudTemplateId.ValueString(),
+		self.Version.ValueString())
+}
+
+// Return an appropriate key that can be used for naming mutexes.
+// Create Read Update Delete: Identifier can be used to prevent concurrent modifications on the
+// versions of the cloud template.
+func (self CloudTemplateVersionModel) LockKey() string {
+	return "cloud-template-" + self.CloudTemplateId.ValueString()
+}
+
+func (self CloudTemplateVersionModel) CreatePath() string {
+	return "blueprint/api/blueprints/" + self.CloudTemplateId.ValueString() + "/versions"
+}
+
+func (self CloudTemplateVersionModel) ReadPath() string {
+	return self.CreatePath() + "/" + self.Version.ValueString()
+}
+
+// The version is released or unreleased by calling an action.
+func (self CloudTemplateVersionModel) UpdatePath() string {
+	if self.Release.ValueBool() {
+		return self.ReadPath() + "/actions/release"
+	}
+	return self.ReadPath() + "/actions/unrelease"
+}
+
+// Versions cannot be deleted, they are deleted with the cloud template.
+func (self CloudTemplateVersionModel) DeletePath() string {
+	return ""
+}
+
+// Identifier of the version: cloud_template_id/version.
+func (self CloudTemplateVersionModel) ComputeId() types.String {
+	return types.StringValue(
+		self.CloudTemplateId.ValueString() + "/" + self.Version.ValueString())
+}
+
+// Set cloud_template_id and version from the identifier (e.g. when importing).
+func (self *CloudTemplateVersionModel) ParseId(id string) error {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || slices.Contains(parts, "") {
+		return fmt.Errorf("identifier %q must be formatted as cloud_template_id/version", id)
+	}
+	self.Id = types.StringValue(id)
+	self.CloudTemplateId = types.StringValue(parts[0])
+	self.Version = types.StringValue(parts[1])
+	return nil
+}
+
+// Return true if the version is released (exposed in the catalog).
+func (self CloudTemplateVersionAPIModel) IsReleased() bool {
+	return self.Status == CLOUD_TEMPLATE_VERSION_RELEASED
+}
+
+func (self *CloudTemplateVersionModel) FromAPI(raw CloudTemplateVersionAPIModel) diag.Diagnostics {
+	self.CloudTemplateId = types.StringValue(raw.BlueprintId)
+	self.Version = types.StringValue(raw.Version)
+	self.Id = self.ComputeId()
+	self.Description = types.StringValue(raw.VersionDescription)
+	self.ChangeLog = types.StringValue(raw.VersionChangeLog)
+	self.Release = types.BoolValue(raw.IsReleased())
+	self.Name = types.StringValue(raw.Name)
+	self.Status = types.StringValue(raw.Status)
+	self.Content = types.StringValue(raw.Content)
+	self.Valid = types.BoolValue(raw.Valid)
+	self.CreatedBy = types.StringValue(raw.CreatedBy)
+	self.ProjectId = types.StringValue(raw.ProjectId)
+	self.OrgId = types.StringValue(raw.OrgId)
+
+	var diags diag.Diagnostics
+	self.CreatedAt, diags = timetypes.NewRFC3339Value(raw.CreatedAt)
+	return diags
+}
+
+func (self CloudTemplateVersionModel) ToAPI() CloudTemplateVersionRequestAPIModel {
+	return CloudTemplateVersionRequestAPIModel{
+		Version:     self.Version.ValueString(),
+		Description: CleanString(self.Description.ValueString()),
+		ChangeLog:   CleanString(self.ChangeLog.ValueString()),
+		Release:     self.Release.ValueBool(),
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CloudTemplateVersionResource{}
+var _ resource.ResourceWithImportState = &CloudTemplateVersionResource{}
+
+func NewCloudTemplateVersionResource() resource.Resource {
+	return &CloudTemplateVersionResource{}
+}
+
+// CloudTemplateVersionResource defines the resource implementation.
+type CloudTemplateVersionResource struct {
+	client *AriaClient
+}
+
+func (self *CloudTemplateVersionResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_template_version"
+}
+
+func (self *CloudTemplateVersionResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = CloudTemplateVersionSchema()
+}
+
+func (self *CloudTemplateVersionResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	self.client = GetResourceClient(ctx, req, resp)
+}
+
+func (self *CloudTemplateVersionResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	// Read Terraform plan data into the model
+	var version CloudTemplateVersionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The version is released while created (if requested)
+	var versionFromAPI CloudTemplateVersionAPIModel
+	path := version.CreatePath()
+	response, err := self.client.R(ctx, path).
+		SetBody(version.ToAPI()).
+		SetResult(&versionFromAPI).
+		Post(path)
+	err = self.client.HandleAPIResponse(response, err, []int{201})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to create %s, got error: %s", version.String(), err))
+		return
+	}
+
+	// Save version into Terraform state
+	resp.Diagnostics.Append(version.FromAPI(versionFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &version)...)
+	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", version.String()))
+}
+
+func (self *CloudTemplateVersionResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	// Read Terraform prior state data into the model
+	var version CloudTemplateVersionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var versionFromAPI CloudTemplateVersionAPIModel
+	found, _, readDiags := self.client.ReadIt(ctx, &version, &versionFromAPI)
+	resp.Diagnostics.Append(readDiags...)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated version into Terraform state
+	resp.Diagnostics.Append(version.FromAPI(versionFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &version)...)
+}
+
+func (self *CloudTemplateVersionResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	// Read Terraform plan data into the model
+	var version CloudTemplateVersionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only release can be changed (the others attributes are forcing a replacement)
+	var versionFromAPI CloudTemplateVersionAPIModel
+	path := version.UpdatePath()
+	response, err := self.client.R(ctx, path).SetResult(&versionFromAPI).Post(path)
+	err = self.client.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to update %s, got error: %s", version.String(), err))
+		return
+	}
+
+	// Save updated version into Terraform state
+	resp.Diagnostics.Append(version.FromAPI(versionFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &version)...)
+	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", version.String()))
+}
+
+func (self *CloudTemplateVersionResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// Read Terraform prior state data into the model
+	var version CloudTemplateVersionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &version)...)
+	if resp.Diagnostics.HasError() || !version.Release.ValueBool() {
+		return
+	}
+
+	// Versions cannot be deleted, the version is unreleased (unless already deleted)
+	version.Release = types.BoolValue(false)
+	path := version.UpdatePath()
+	response, err := self.client.R(ctx, path).Post(path)
+	if response != nil && response.StatusCode() == 404 {
+		tflog.Debug(ctx, fmt.Sprintf("%s already deleted", version.String()))
+		return
+	}
+	err = self.client.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to unrelease %s, got error: %s", version.String(), err))
+	}
+}
+
+func (self *CloudTemplateVersionResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	var version CloudTemplateVersionModel
+	if err := version.ParseId(req.ID); err != nil {
+		resp.Diagnostics.AddError("Configuration error", fmt.Sprintf("Unable to import: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &version)...)
+}
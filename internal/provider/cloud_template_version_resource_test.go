@@ -0,0 +1,176 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudTemplateVersionResource(t *testing.T) {
+	config := func(version string, release bool) string {
+		return fmt.Sprintf(`
+variable "test_project_id" {
+  description = "Project where to generate test resources."
+  type        = string
+}
+
+resource "aria_cloud_template_v1" "test" {
+  name              = "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE_VERSION"
+  description       = "Temporary cloud template generated by Aria provider's acceptance tests."
+  project_id        = var.test_project_id
+  request_scope_org = false
+
+  content = <<-EOT
+    formatVersion: 1
+    inputs: {}
+    resources:
+      Machine_1:
+        type: Cloud.vSphere.Machine
+        properties:
+          image: ubuntu
+          flavor: small
+  EOT
+}
+
+resource "aria_cloud_template_version" "test" {
+  cloud_template_id = aria_cloud_template_v1.test.id
+  version           = "%s"
+  description       = "First version"
+  change_log        = "Initial release"
+  release           = %t
+}
+
+resource "aria_catalog_source" "test" {
+  name        = "ARIA_PROVIDER_TEST_CATALOG_SOURCE_FOR_CLOUD_TEMPLATES"
+  description = "Temporary catalog source generated by Aria provider's acceptance tests."
+  project_id  = var.test_project_id
+  type_id     = "com.vmw.blueprint"
+
+  config = {
+    source_project_id = var.test_project_id
+  }
+
+  # Import the cloud templates every time a new version is released
+  import_trigger = aria_cloud_template_version.test.id
+
+  timeouts {
+    create = "1m"
+    update = "1m"
+  }
+}
+`, version, release)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("1.0.0", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"aria_cloud_template_version.test", "cloud_template_id",
+						"aria_cloud_template_v1.test", "id",
+					),
+					resource.TestMatchResourceAttr(
+						"aria_cloud_template_version.test", "id",
+						regexp.MustCompile("^[0-9a-f-]{36}/1.0.0$"),
+					),
+					resource.TestCheckResourceAttr("aria_cloud_template_version.test", "version", "1.0.0"),
+					resource.TestCheckResourceAttr("aria_cloud_template_version.test", "description", "First version"),
+					resource.TestCheckResourceAttr("aria_cloud_template_version.test", "change_log", "Initial release"),
+					resource.TestCheckResourceAttr("aria_cloud_template_version.test", "release", "false"),
+					resource.TestCheckResourceAttr("aria_cloud_template_version.test", "status", "VERSIONED"),
+					resource.TestCheckResourceAttr("aria_cloud_template_version.test", "name", "ARIA_PROVIDER_TEST_CLOUD_TEMPLATE_VERSION"),
+					resource.TestMatchResourceAttr(
+						"aria_cloud_template_version.test", "content",
+						regexp.MustCompile("Cloud.vSphere.Machine"),
+					),
+					resource.TestCheckResourceAttr("aria_cloud_template_version.test", "valid", "true"),
+					resource.TestCheckResourceAttrSet("aria_cloud_template_version.test", "created_at"),
+					resource.TestCheckResourceAttrSet("aria_cloud_template_version.test", "created_by"),
+					resource.TestCheckResourceAttrPair(
+						"aria_cloud_template_version.test", "project_id",
+						"aria_cloud_template_v1.test", "project_id",
+					),
+					resource.TestCheckResourceAttrSet("aria_cloud_template_version.test", "org_id"),
+					resource.TestCheckResourceAttr("aria_catalog_source.test", "items_imported", "0"),
+				),
+			},
+			// Update testing (release the version)
+			{
+				Config: config("1.0.0", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_cloud_template_version.test", "release", "true"),
+					resource.TestCheckResourceAttr("aria_cloud_template_version.test", "status", "RELEASED"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aria_cloud_template_version.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing (unrelease the version)
+			{
+				Config: config("1.0.0", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_cloud_template_version.test", "release", "false"),
+					resource.TestCheckResourceAttr("aria_cloud_template_version.test", "status", "VERSIONED"),
+				),
+			},
+			// Release a new version (replacing the previous one), the catalog source is importing it
+			// The version is unreleased on destroy
+			{
+				Config: config("1.1.0", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"aria_cloud_template_version.test", "id",
+						regexp.MustCompile("^[0-9a-f-]{36}/1.1.0$"),
+					),
+					resource.TestCheckResourceAttr("aria_cloud_template_version.test", "status", "RELEASED"),
+					resource.TestCheckResourceAttr("aria_catalog_source.test", "items_imported", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCloudTemplateVersionResource_Errors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid identifier
+			{
+				Config: `
+resource "aria_cloud_template_version" "test" {
+  cloud_template_id = "some-cloud-template"
+  version           = "1.0.0"
+}
+`,
+				ResourceName:  "aria_cloud_template_version.test",
+				ImportState:   true,
+				ImportStateId: "some-cloud-template",
+				ExpectError:   regexp.MustCompile(`must\s+be\s+formatted\s+as\s+cloud_template_id/version`),
+			},
+			// Cloud template not found
+			{
+				Config: `
+resource "aria_cloud_template_version" "test" {
+  cloud_template_id = "00000000-0000-0000-0000-000000000000"
+  version           = "1.0.0"
+}
+`,
+				ExpectError: regexp.MustCompile(`Unable\s+to\s+create\s+Cloud\s+Template`),
+			},
+		},
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func CloudTemplateVersionSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: strings.Join([]string{
+			"Cloud Template version resource, a snapshot of the content of the cloud template " +
+				"that can be released to the catalog.",
+			"",
+			"Versions cannot be deleted, a released version is unreleased on destroy and is " +
+				"deleted along with its cloud template.",
+			"",
+			"Set `import_trigger` of a catalog source (of type `com.vmw.blueprint`) to the " +
+				"`id` (or `version`) of the released version to import it.",
+		}, "\n"),
+		Attributes: map[string]schema.Attribute{
+			"id": ComputedIdentifierSchema(
+				"Identifier (cloud template identifier and version separated by a slash)"),
+			"cloud_template_id": RequiredImmutableIdentifierSchema(
+				"Cloud template identifier" + IMMUTABLE),
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version (e.g. `1.0.0`)" + IMMUTABLE,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Describe the version in few sentences" + IMMUTABLE,
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"change_log": schema.StringAttribute{
+				MarkdownDescription: "Changes made since the previous version" + IMMUTABLE,
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"release": schema.BoolAttribute{
+				MarkdownDescription: "Release the version to the catalog (it is unreleased if " +
+					"set to false, default is false)",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the cloud template",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status, either `VERSIONED` or `RELEASED`",
+				Computed:            true,
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Content (YAML) of the cloud template when versioned",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"valid": schema.BoolAttribute{
+				MarkdownDescription: "Was the content valid when versioned?",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp (RFC3339)",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "User who created the version",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project identifier of the cloud template",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": ComputedOrganizationIdSchema(),
+		},
+	}
+}
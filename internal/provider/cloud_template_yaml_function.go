@@ -0,0 +1,110 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &CloudTemplateYAMLFunction{}
+
+func NewCloudTemplateYAMLFunction() function.Function {
+	return &CloudTemplateYAMLFunction{}
+}
+
+// CloudTemplateYAMLFunction defines the function implementation.
+type CloudTemplateYAMLFunction struct{}
+
+func (self *CloudTemplateYAMLFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "cloud_template_yaml"
+}
+
+func (self *CloudTemplateYAMLFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Render the content (YAML) of a cloud template",
+		MarkdownDescription: "Render the content (YAML) of a cloud template from its inputs and " +
+			"resources, the way `aria_cloud_template_v1` sends it to the API. Attributes are the " +
+			"same as the resource's `inputs` and `resources` (`name` defaults to the key).",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "inputs",
+				MarkdownDescription: "Cloud Template's properties (map of objects)",
+			},
+			function.DynamicParameter{
+				Name:                "resources",
+				MarkdownDescription: "Cloud Template's resources (map of objects)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (self *CloudTemplateYAMLFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var inputsValue, resourcesValue types.Dynamic
+	resp.Error = req.Arguments.Get(ctx, &inputsValue, &resourcesValue)
+	if resp.Error != nil {
+		return
+	}
+
+	template := CloudTemplateV1Model{
+		Inputs:    UnorderedPropertiesModel{},
+		Resources: CloudTemplateResourcesModel{},
+	}
+
+	inputs, err := MapFromDynamic(ctx, "inputs", inputsValue, PropertySchema(), "name")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	for key, object := range inputs {
+		input := PropertyModel{}
+		diags := object.As(ctx, &input, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			resp.Error = function.NewArgumentFuncError(0, diags.Errors()[0].Detail())
+			return
+		}
+		template.Inputs[key] = input
+	}
+
+	resources, err := MapFromDynamic(
+		ctx, "resources", resourcesValue, CloudTemplateResourceSchema(), "name")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	for key, object := range resources {
+		resource := CloudTemplateResourceModel{}
+		diags := object.As(ctx, &resource, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			resp.Error = function.NewArgumentFuncError(1, diags.Errors()[0].Detail())
+			return
+		}
+		template.Resources[key] = resource
+	}
+
+	content, diags := template.ContentToAPI(ctx)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, content)
+}
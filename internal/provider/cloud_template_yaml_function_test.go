@@ -0,0 +1,217 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Return the value as Terraform passes it to dynamic parameters (objects, tuples, ...).
+func DynamicValueOf(t *testing.T, value any) attr.Value {
+	switch value := value.(type) {
+	case string:
+		return types.StringValue(value)
+	case bool:
+		return types.BoolValue(value)
+	case int:
+		return types.NumberValue(big.NewFloat(float64(value)))
+	case float64:
+		return types.NumberValue(big.NewFloat(value))
+	case []any:
+		elements := []attr.Value{}
+		elementTypes := []attr.Type{}
+		for _, element := range value {
+			elementValue := DynamicValueOf(t, element)
+			elements = append(elements, elementValue)
+			elementTypes = append(elementTypes, elementValue.Type(t.Context()))
+		}
+		return types.TupleValueMust(elementTypes, elements)
+	case map[string]any:
+		attributes := map[string]attr.Value{}
+		attributeTypes := map[string]attr.Type{}
+		for key, attribute := range value {
+			attributes[key] = DynamicValueOf(t, attribute)
+			attributeTypes[key] = attributes[key].Type(t.Context())
+		}
+		return types.ObjectValueMust(attributeTypes, attributes)
+	}
+	t.Fatalf("Unsupported value %v", value)
+	return nil
+}
+
+func TestCloudTemplateYAMLFunction(t *testing.T) {
+	inputs := map[string]any{
+		"flavor": map[string]any{
+			"title":              "Flavor",
+			"description":        "Size of the VM.",
+			"type":               "string",
+			"default":            `"small"`,
+			"encrypted":          false,
+			"read_only":          false,
+			"recreate_on_update": true,
+			"one_of": []any{
+				map[string]any{"const": "small", "title": "Small", "encrypted": false},
+				map[string]any{"const": "large", "title": "Large", "encrypted": false},
+			},
+		},
+		"count": map[string]any{
+			"name":               "count",
+			"title":              "Count",
+			"description":        "",
+			"type":               "integer",
+			"default":            "1",
+			"encrypted":          false,
+			"read_only":          false,
+			"recreate_on_update": false,
+			"minimum":            1,
+			"maximum":            5,
+		},
+	}
+	resources := map[string]any{
+		"network": map[string]any{
+			"type":       "Cloud.vSphere.Network",
+			"metadata":   map[string]any{"layout_position": []any{0, 0}},
+			"properties": `{"networkType":"existing"}`,
+		},
+		"vm": map[string]any{
+			"type":                  "Cloud.vSphere.Machine",
+			"metadata":              map[string]any{"layout_position": []any{0, 1}},
+			"depends_on":            []any{"network"},
+			"allocate_per_instance": true,
+			"properties": `{"flavor":"${input.flavor}","count":"${input.count}",` +
+				`"networks":[{"network":"${resource.network.id}"}]}`,
+		},
+	}
+
+	content := RunFunction(
+		t, NewCloudTemplateYAMLFunction(), "",
+		types.DynamicValue(DynamicValueOf(t, inputs)),
+		types.DynamicValue(DynamicValueOf(t, resources)))
+	CheckEqual(t, content, `inputs:
+  count:
+    title: Count
+    description: ""
+    type: integer
+    default: 1
+    encrypted: false
+    readOnly: false
+    recreateOnUpdate: false
+    minimum: 1
+    maximum: 5
+    pattern: ""
+  flavor:
+    title: Flavor
+    description: Size of the VM.
+    type: string
+    default: small
+    encrypted: false
+    readOnly: false
+    recreateOnUpdate: true
+    pattern: ""
+    oneOf:
+    - const: small
+      title: Small
+      encrypted: false
+    - const: large
+      title: Large
+      encrypted: false
+resources:
+  network:
+    type: Cloud.vSphere.Network
+    metadata:
+      layoutPosition:
+      - 0
+      - 0
+    properties:
+      networkType: existing
+  vm:
+    type: Cloud.vSphere.Machine
+    metadata:
+      layoutPosition:
+      - 0
+      - 1
+    dependsOn:
+    - network
+    allocatePerInstance: true
+    properties:
+      count: ${input.count}
+      flavor: ${input.flavor}
+      networks:
+      - network: ${resource.network.id}
+`)
+
+	// Same content as the one sent by the resource
+	template := CloudTemplateV1Model{}
+	diags := template.FromAPI(t.Context(), CloudTemplateV1APIModel{Content: content})
+	CheckDiagnostics(t, diags, "", "")
+	contentFromModel, diags := template.ContentToAPI(t.Context())
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, contentFromModel, content)
+}
+
+func TestCloudTemplateYAMLFunction_Errors(t *testing.T) {
+	cases := []struct {
+		name         string
+		inputs       any
+		resources    any
+		errorMessage string
+	}{
+		{
+			name:         "inputs is not a map",
+			inputs:       "flavor",
+			resources:    map[string]any{},
+			errorMessage: "inputs must be an object or a map",
+		},
+		{
+			name:         "missing required attribute",
+			inputs:       map[string]any{"flavor": map[string]any{"title": "Flavor"}},
+			resources:    map[string]any{},
+			errorMessage: `inputs["flavor"].description is required`,
+		},
+		{
+			name:         "unknown attribute",
+			inputs:       map[string]any{},
+			resources:    map[string]any{"vm": map[string]any{"type": "VM", "count": 2}},
+			errorMessage: `resources["vm"].count is not supported`,
+		},
+		{
+			name:         "attribute of the wrong type",
+			inputs:       map[string]any{},
+			resources:    map[string]any{"vm": map[string]any{"type": true}},
+			errorMessage: `resources["vm"].type must be a string`,
+		},
+		{
+			name:         "list element of the wrong type",
+			inputs:       map[string]any{},
+			resources:    map[string]any{"vm": map[string]any{"type": "VM", "depends_on": []any{1}}},
+			errorMessage: `resources["vm"].depends_on[0] must be a string`,
+		},
+		{
+			name:   "nested attribute of the wrong type",
+			inputs: map[string]any{},
+			resources: map[string]any{"vm": map[string]any{
+				"type": "VM", "metadata": map[string]any{"layout_position": []any{"top", 1}},
+			}},
+			errorMessage: `resources["vm"].metadata.layout_position[0] must be an integer`,
+		},
+		{
+			name:         "name is not the key",
+			inputs:       map[string]any{},
+			resources:    map[string]any{"vm": map[string]any{"name": "db", "type": "VM"}},
+			errorMessage: "must be declared in map on key db and not vm",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			RunFunction(
+				t, NewCloudTemplateYAMLFunction(), c.errorMessage,
+				types.DynamicValue(DynamicValueOf(t, c.inputs)),
+				types.DynamicValue(DynamicValueOf(t, c.resources)))
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CloudZoneDataSource{}
+
+func NewCloudZoneDataSource() datasource.DataSource {
+	return &CloudZoneDataSource{}
+}
+
+// CloudZoneDataSource defines the data source implementation.
+type CloudZoneDataSource struct {
+	client *AriaClient
+}
+
+func (self *CloudZoneDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_zone"
+}
+
+func (self *CloudZoneDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = CloudZoneDataSourceSchema()
+}
+
+func (self *CloudZoneDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self CloudZoneDataSource) ConfigValidators(
+	ctx context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (self *CloudZoneDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var zone CloudZoneModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &zone)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Lookup the cloud zone by name (the filter may be case insensitive)
+	if len(zone.Id.ValueString()) == 0 {
+		name := zone.Name.ValueString()
+		zoneRaw, err := FindOne(ctx, self.client, zone.ListQuery(),
+			func(raw CloudZoneAPIModel) bool { return raw.Name == name })
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client error",
+				fmt.Sprintf("Unable to find %s, got error: %s", zone.String(), err))
+			return
+		}
+		zone.Id = types.StringValue(zoneRaw.Id)
+	}
+
+	var zoneFromAPI CloudZoneAPIModel
+	found, _, readDiags := self.client.ReadIt(ctx, &zone, &zoneFromAPI)
+	resp.Diagnostics.Append(readDiags...)
+	if !found {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to find %s, got error: not found", zone.String()))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save cloud zone into Terraform state
+	resp.Diagnostics.Append(zone.FromAPI(ctx, zoneFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &zone)...)
+}
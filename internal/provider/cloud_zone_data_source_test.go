@@ -0,0 +1,57 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudZoneDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+variable "test_zone_id" {
+  description = "Cloud zone to lookup."
+  type        = string
+}
+
+data "aria_cloud_zone" "by_id" {
+  id = var.test_zone_id
+}
+
+data "aria_cloud_zone" "by_name" {
+  name = data.aria_cloud_zone.by_id.name
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aria_cloud_zone.by_id", "name"),
+					resource.TestCheckResourceAttrSet("data.aria_cloud_zone.by_id", "region_id"),
+					resource.TestCheckResourceAttrSet("data.aria_cloud_zone.by_id", "org_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.aria_cloud_zone.by_name", "id",
+						"data.aria_cloud_zone.by_id", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.aria_cloud_zone.by_name", "placement_policy",
+						"data.aria_cloud_zone.by_id", "placement_policy",
+					),
+				),
+			},
+			// Lookup failure testing
+			{
+				Config: `
+data "aria_cloud_zone" "missing" {
+  name = "NO-CLOUD-ZONE-IS-NAMED-LIKE-THIS"
+}`,
+				ExpectError: regexp.MustCompile(`no\s+instance\s+of\s+iaas/api/zones`),
+			},
+		},
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Placement policies of the resources into the computes of the zone.
+var CLOUD_ZONE_PLACEMENT_POLICIES = []string{"DEFAULT", "BINPACK", "SPREAD", "SPREAD_MEMORY"}
+
+// CloudZoneModel describes the resource data model.
+type CloudZoneModel struct {
+	Id               types.String       `tfsdk:"id"`
+	Name             types.String       `tfsdk:"name"`
+	Description      types.String       `tfsdk:"description"`
+	RegionId         types.String       `tfsdk:"region_id"`
+	PlacementPolicy  types.String       `tfsdk:"placement_policy"`
+	Folder           types.String       `tfsdk:"folder"`
+	Tags             []KeyValueTagModel `tfsdk:"tags"`
+	TagsToMatch      []KeyValueTagModel `tfsdk:"tags_to_match"`
+	CustomProperties types.Map          `tfsdk:"custom_properties"`
+	OrgId            types.String       `tfsdk:"org_id"`
+}
+
+// CloudZoneAPIModel describes the resource API model.
+type CloudZoneAPIModel struct {
+	Id               string                `json:"id,omitempty"`
+	Name             string                `json:"name"`
+	Description      string                `json:"description"`
+	RegionId         string                `json:"regionId"`
+	PlacementPolicy  string                `json:"placementPolicy"`
+	Folder           string                `json:"folder"`
+	Tags             []KeyValueTagAPIModel `json:"tags"`
+	TagsToMatch      []KeyValueTagAPIModel `json:"tagsToMatch"`
+	CustomProperties map[string]string     `json:"customProperties"`
+	OrgId            string                `json:"orgId,omitempty"`
+
+	// The region is returned as a link
+	Links *IaaSLinksAPIModel `json:"_links,omitempty"`
+}
+
+func (self CloudZoneModel) String() string {
+	return fmt.Sprintf(
+		"Cloud Zone %s (%s)",
+		self.Id.ValueString(),
+		self.Name.ValueString())
+}
+
+// Return an appropriate key that can be used for naming mutexes.
+// Create: Identifier can be used to prevent concurrent creation of cloud zones.
+// Read Update Delete: Identifier can be used to prevent concurrent modifications on the instance.
+func (self CloudZoneModel) LockKey() string {
+	return "cloud-zone-" + self.Id.ValueString()
+}
+
+func (self CloudZoneModel) ListPath() string {
+	return "iaas/api/zones"
+}
+
+// Return the query retrieving the cloud zones named like the cloud zone to lookup.
+func (self CloudZoneModel) ListQuery() ListQuery {
+	return ListQuery{
+		Path:       self.ListPath(),
+		Pagination: LIST_PAGINATION_ODATA,
+		Filter:     "name eq " + ODataString(self.Name.ValueString()),
+	}
+}
+
+func (self CloudZoneModel) CreatePath() string {
+	return "iaas/api/zones"
+}
+
+func (self CloudZoneModel) ReadPath() string {
+	return "iaas/api/zones/" + self.Id.ValueString()
+}
+
+func (self CloudZoneModel) UpdatePath() string {
+	return self.ReadPath()
+}
+
+func (self CloudZoneModel) DeletePath() string {
+	return self.ReadPath()
+}
+
+func (self *CloudZoneModel) FromAPI(
+	ctx context.Context,
+	raw CloudZoneAPIModel,
+) diag.Diagnostics {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Description = types.StringValue(raw.Description)
+	self.RegionId = types.StringValue(RegionIdFromAPI(raw.RegionId, raw.Links))
+	self.PlacementPolicy = types.StringValue(raw.PlacementPolicy)
+	self.Folder = types.StringValue(raw.Folder)
+	self.Tags = KeyValueTagsFromAPI(raw.Tags)
+	self.TagsToMatch = KeyValueTagsFromAPI(raw.TagsToMatch)
+	self.OrgId = types.StringValue(raw.OrgId)
+
+	customProperties := raw.CustomProperties
+	if customProperties == nil {
+		customProperties = map[string]string{}
+	}
+	var diags diag.Diagnostics
+	self.CustomProperties, diags = types.MapValueFrom(ctx, types.StringType, customProperties)
+	return diags
+}
+
+func (self CloudZoneModel) ToAPI(ctx context.Context) (CloudZoneAPIModel, diag.Diagnostics) {
+	customPropertiesRaw := make(map[string]string, len(self.CustomProperties.Elements()))
+	diags := self.CustomProperties.ElementsAs(ctx, &customPropertiesRaw, false)
+	return CloudZoneAPIModel{
+		Id:               self.Id.ValueString(),
+		Name:             self.Name.ValueString(),
+		Description:      self.Description.ValueString(),
+		RegionId:         self.RegionId.ValueString(),
+		PlacementPolicy:  self.PlacementPolicy.ValueString(),
+		Folder:           self.Folder.ValueString(),
+		Tags:             KeyValueTagsToAPI(self.Tags),
+		TagsToMatch:      KeyValueTagsToAPI(self.TagsToMatch),
+		CustomProperties: customPropertiesRaw,
+		OrgId:            self.OrgId.ValueString(),
+	}, diags
+}
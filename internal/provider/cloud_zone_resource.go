@@ -0,0 +1,178 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CloudZoneResource{}
+var _ resource.ResourceWithImportState = &CloudZoneResource{}
+
+func NewCloudZoneResource() resource.Resource {
+	return &CloudZoneResource{}
+}
+
+// CloudZoneResource defines the resource implementation.
+type CloudZoneResource struct {
+	client *AriaClient
+}
+
+func (self *CloudZoneResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_zone"
+}
+
+func (self *CloudZoneResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = CloudZoneSchema()
+}
+
+func (self *CloudZoneResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	self.client = GetResourceClient(ctx, req, resp)
+}
+
+func (self *CloudZoneResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	// Read Terraform plan data into the model
+	var zone CloudZoneModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &zone)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneToAPI, diags := zone.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var zoneFromAPI CloudZoneAPIModel
+	path := zone.CreatePath()
+	response, err := self.client.R(ctx, path).
+		SetBody(zoneToAPI).
+		SetResult(&zoneFromAPI).
+		Post(path)
+	err = self.client.HandleAPIResponse(response, err, []int{201})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to create %s, got error: %s", zone.String(), err))
+		return
+	}
+
+	// Save cloud zone into Terraform state
+	resp.Diagnostics.Append(zone.FromAPI(ctx, zoneFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &zone)...)
+	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", zone.String()))
+}
+
+func (self *CloudZoneResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	// Read Terraform prior state data into the model
+	var zone CloudZoneModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &zone)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var zoneFromAPI CloudZoneAPIModel
+	found, _, readDiags := self.client.ReadIt(ctx, &zone, &zoneFromAPI)
+	resp.Diagnostics.Append(readDiags...)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated cloud zone into Terraform state
+	resp.Diagnostics.Append(zone.FromAPI(ctx, zoneFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &zone)...)
+}
+
+func (self *CloudZoneResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	// Read Terraform plan data into the model
+	var zone CloudZoneModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &zone)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneToAPI, diags := zone.ToAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var zoneFromAPI CloudZoneAPIModel
+	path := zone.UpdatePath()
+	response, err := self.client.R(ctx, path).
+		SetBody(zoneToAPI).
+		SetResult(&zoneFromAPI).
+		Patch(path)
+	err = self.client.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to update %s, got error: %s", zone.String(), err))
+		return
+	}
+
+	// Save updated cloud zone into Terraform state
+	resp.Diagnostics.Append(zone.FromAPI(ctx, zoneFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &zone)...)
+	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", zone.String()))
+}
+
+func (self *CloudZoneResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// Read Terraform prior state data into the model
+	var zone CloudZoneModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &zone)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(self.client.DeleteIt(ctx, &zone)...)
+}
+
+func (self *CloudZoneResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,84 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudZoneResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region of the cloud zone."
+  type        = string
+}
+
+resource "aria_cloud_zone" "test" {
+  name      = "ARIA_PROVIDER_TEST_CLOUD_ZONE"
+  region_id = var.test_region_id
+
+  tags = [{ key = "env", value = "test" }]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aria_cloud_zone.test", "id"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "name", "ARIA_PROVIDER_TEST_CLOUD_ZONE"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "description", ""),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "placement_policy", "DEFAULT"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "folder", ""),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "tags.0.key", "env"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "tags.0.value", "test"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "tags_to_match.#", "0"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "custom_properties.%", "0"),
+					resource.TestCheckResourceAttrSet("aria_cloud_zone.test", "org_id"),
+				),
+			},
+			// Update testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region of the cloud zone."
+  type        = string
+}
+
+resource "aria_cloud_zone" "test" {
+  name             = "ARIA_PROVIDER_TEST_CLOUD_ZONE"
+  description      = "Cloud zone managed by the acceptance tests."
+  region_id        = var.test_region_id
+  placement_policy = "SPREAD"
+
+  tags = [
+    { key = "env", value = "test" },
+    { key = "tier", value = "gold" },
+  ]
+  tags_to_match = [{ key = "cluster", value = "test" }]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "description", "Cloud zone managed by the acceptance tests."),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "placement_policy", "SPREAD"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "tags_to_match.#", "1"),
+					resource.TestCheckResourceAttr("aria_cloud_zone.test", "tags_to_match.0.key", "cluster"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aria_cloud_zone.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func CloudZoneSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Cloud zone resource, the computes of a region where the resources " +
+			"of the projects are provisioned",
+		Attributes: map[string]schema.Attribute{
+			"id": ComputedIdentifierSchema(""),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "A friendly name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Describe the resource in few sentences",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "Region identifier (e.g. one of the `region_ids` of " +
+					"`aria_cloud_account_vsphere`)" + IMMUTABLE,
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"placement_policy": schema.StringAttribute{
+				MarkdownDescription: "Placement policy of the resources into the computes, " +
+					"`DEFAULT` (the default), `BINPACK` (most loaded first), `SPREAD` (by number " +
+					"of resources) or `SPREAD_MEMORY` (by allocated memory)",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString("DEFAULT"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(CLOUD_ZONE_PLACEMENT_POLICIES...),
+				},
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "Folder where the machines are provisioned (vSphere only), " +
+					"default is empty",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"tags": KeyValueTagsSchema(
+				"Capability tags of the zone, matched by the constraints of the cloud templates " +
+					"and projects (default is none)"),
+			"tags_to_match": KeyValueTagsSchema(
+				"Computes of the region having those tags are included into the zone " +
+					"(default is none)"),
+			"custom_properties": schema.MapAttribute{
+				MarkdownDescription: "Custom properties of the zone (default is none)",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Optional:            true,
+				Default: mapdefault.StaticValue(
+					types.MapValueMust(types.StringType, map[string]attr.Value{})),
+			},
+			"org_id": ComputedOrganizationIdSchema(),
+		},
+	}
+}
+
+func CloudZoneDataSourceSchema() dataschema.Schema {
+	return dataschema.Schema{
+		MarkdownDescription: "Cloud zone data source, lookup by identifier or name",
+		Attributes: map[string]dataschema.Attribute{
+			"id": OptionalIdentifierSchema(""),
+			"name": dataschema.StringAttribute{
+				MarkdownDescription: "Cloud zone name (must match exactly one cloud zone)",
+				Computed:            true,
+				Optional:            true,
+			},
+			"description": ComputedDescriptionSchema(),
+			"region_id": dataschema.StringAttribute{
+				MarkdownDescription: "Region identifier",
+				Computed:            true,
+			},
+			"placement_policy": dataschema.StringAttribute{
+				MarkdownDescription: "Placement policy of the resources into the computes",
+				Computed:            true,
+			},
+			"folder": dataschema.StringAttribute{
+				MarkdownDescription: "Folder where the machines are provisioned (vSphere only)",
+				Computed:            true,
+			},
+			"tags":          ComputedKeyValueTagsSchema("Capability tags of the zone"),
+			"tags_to_match": ComputedKeyValueTagsSchema("Tags of the computes of the zone"),
+			"custom_properties": dataschema.MapAttribute{
+				MarkdownDescription: "Custom properties of the zone",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"org_id": ComputedOrganizationIdSchema(),
+		},
+	}
+}
@@ -64,7 +64,7 @@ func (self *CustomFormResource) Create(
 	// First, try to fetch (existing form)
 	var formFromFetchAPI CustomFormAPIModel
 	path := form.FetchPath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetQueryParam("formFormat", "JSON").
 		SetQueryParam("formType", form.Type.ValueString()).
 		SetQueryParam("sourceId", form.SourceId.ValueString()).
@@ -84,7 +84,7 @@ func (self *CustomFormResource) Create(
 
 	// Then create (or update) it
 	path = form.CreatePath()
-	response, err = self.client.R(path).SetBody(form.ToAPI()).Post(path)
+	response, err = self.client.R(ctx, path).SetBody(form.ToAPI()).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{201})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -96,7 +96,7 @@ func (self *CustomFormResource) Create(
 	// Read (using API) to retrieve the custom form content (and not empty stuff)
 	var formFromAPI CustomFormAPIModel
 	path = form.ReadPath()
-	response, err = self.client.R(path).SetResult(&formFromAPI).Get(path)
+	response, err = self.client.R(ctx, path).SetResult(&formFromAPI).Get(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -124,7 +124,7 @@ func (self *CustomFormResource) Read(
 	}
 
 	var formFromAPI CustomFormAPIModel
-	found, _, readDiags := self.client.ReadIt(&form, &formFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &form, &formFromAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -153,7 +153,7 @@ func (self *CustomFormResource) Update(
 	}
 
 	path := form.UpdatePath()
-	response, err := self.client.R(path).SetBody(form.ToAPI()).Post(path)
+	response, err := self.client.R(ctx, path).SetBody(form.ToAPI()).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{201})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -165,7 +165,7 @@ func (self *CustomFormResource) Update(
 	// Read (using API) to retrieve the custom form content (and not empty stuff)
 	var formFromAPI CustomFormAPIModel
 	path = form.ReadPath()
-	response, err = self.client.R(path).SetResult(&formFromAPI).Get(path)
+	response, err = self.client.R(ctx, path).SetResult(&formFromAPI).Get(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -190,7 +190,7 @@ func (self *CustomFormResource) Delete(
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &form)...)
 	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&form)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &form)...)
 	}
 }
 
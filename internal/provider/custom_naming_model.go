@@ -6,6 +6,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -106,8 +108,10 @@ func (self *CustomNamingModel) ToAPI(
 		projectsRaw = append(projectsRaw, project.ToAPI())
 	}
 
+	// Sorted by key to send the templates in a stable order
 	templatesRaw := []CustomNamingTemplateAPIModel{}
-	for key, template := range self.Templates {
+	for _, key := range slices.Sorted(maps.Keys(self.Templates)) {
+		template := self.Templates[key]
 		templateState, found := state.Templates[key]
 		if !found {
 			templateState = CustomNamingTemplateModel{}
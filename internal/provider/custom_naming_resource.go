@@ -69,7 +69,7 @@ func (self *CustomNamingResource) Create(
 
 	var namingFromAPI CustomNamingAPIModel
 	path := naming.CreatePath()
-	response, err := self.client.R(path).SetBody(namingToAPI).SetResult(&namingFromAPI).Post(path)
+	response, err := self.client.R(ctx, path).SetBody(namingToAPI).SetResult(&namingFromAPI).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{201})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -83,7 +83,7 @@ func (self *CustomNamingResource) Create(
 
 	// Read (using API) to retrieve the projects & templates (and counters)
 	path = naming.ReadPath()
-	response, err = self.client.R(path).SetResult(&namingFromAPI).Get(path)
+	response, err = self.client.R(ctx, path).SetResult(&namingFromAPI).Get(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -111,7 +111,7 @@ func (self *CustomNamingResource) Read(
 	}
 
 	var namingFromAPI CustomNamingAPIModel
-	found, _, readDiags := self.client.ReadIt(&naming, &namingFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &naming, &namingFromAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -148,7 +148,7 @@ func (self *CustomNamingResource) Update(
 
 	var namingFromAPI CustomNamingAPIModel
 	path := naming.UpdatePath()
-	response, err := self.client.R(path).SetBody(namingToAPI).SetResult(&namingFromAPI).Put(path)
+	response, err := self.client.R(ctx, path).SetBody(namingToAPI).SetResult(&namingFromAPI).Put(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -172,7 +172,7 @@ func (self *CustomNamingResource) Delete(
 	var naming CustomNamingModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &naming)...)
 	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&naming)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &naming)...)
 	}
 }
 
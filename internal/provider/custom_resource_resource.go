@@ -38,7 +38,7 @@ func (self *CustomResourceResource) Schema(
 	req resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
-	resp.Schema = CustomResourceSchema()
+	resp.Schema = CustomResourceSchema(ctx)
 }
 
 func (self *CustomResourceResource) Configure(
@@ -61,6 +61,18 @@ func (self *CustomResourceResource) Create(
 		return
 	}
 
+	// Concurrent operations on the same instance must replay their own API calls
+	ctx = WithCassetteOperation(ctx, resource.String())
+
+	createTimeout, timeoutDiags := resource.Timeouts.Create(ctx, DEFAULT_CREATE_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	resourceToAPI, diags := resource.ToAPI(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -69,7 +81,7 @@ func (self *CustomResourceResource) Create(
 
 	var resourceFromAPI CustomResourceAPIModel
 	path := resource.CreatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetBody(resourceToAPI).
 		SetResult(&resourceFromAPI).
 		Post(path)
@@ -99,9 +111,11 @@ func (self *CustomResourceResource) Read(
 		return
 	}
 
+	ctx = WithCassetteOperation(ctx, resource.String())
+
 	var resourceFromAPI CustomResourceAPIModel
 	self.client.Mutex.RLock(ctx, resource.LockKey())
-	found, _, diags := self.client.ReadIt(&resource, &resourceFromAPI)
+	found, _, diags := self.client.ReadIt(ctx, &resource, &resourceFromAPI)
 	self.client.Mutex.RUnlock(ctx, resource.LockKey())
 	resp.Diagnostics.Append(diags...)
 
@@ -131,6 +145,17 @@ func (self *CustomResourceResource) Update(
 		return
 	}
 
+	ctx = WithCassetteOperation(ctx, resource.String())
+
+	updateTimeout, timeoutDiags := resource.Timeouts.Update(ctx, DEFAULT_UPDATE_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	resourceToAPI, diags := resource.ToAPI(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -141,7 +166,7 @@ func (self *CustomResourceResource) Update(
 
 	// Read resource to retrieve latest value for additional actions
 	var resourceFromAPI CustomResourceAPIModel
-	found, _, diags := self.client.ReadIt(&resource, &resourceFromAPI)
+	found, _, diags := self.client.ReadIt(ctx, &resource, &resourceFromAPI)
 	resp.Diagnostics.Append(diags...)
 
 	if !found || resp.Diagnostics.HasError() {
@@ -163,7 +188,7 @@ func (self *CustomResourceResource) Update(
 	// Reset to prevent muxing of old/new data
 	resourceFromAPI = CustomResourceAPIModel{}
 	path := resource.UpdatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetBody(resourceToAPI).
 		SetResult(&resourceFromAPI).
 		Post(path)
@@ -192,11 +217,24 @@ func (self *CustomResourceResource) Delete(
 	// Read Terraform prior state data into the model
 	var resource CustomResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &resource)...)
-	if !resp.Diagnostics.HasError() {
-		self.client.Mutex.Lock(ctx, resource.LockKey())
-		resp.Diagnostics.Append(self.client.DeleteIt(&resource)...)
-		self.client.Mutex.Unlock(ctx, resource.LockKey())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = WithCassetteOperation(ctx, resource.String())
+
+	deleteTimeout, timeoutDiags := resource.Timeouts.Delete(ctx, DEFAULT_DELETE_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	self.client.Mutex.Lock(ctx, resource.LockKey())
+	resp.Diagnostics.Append(self.client.DeleteIt(ctx, &resource)...)
+	self.client.Mutex.Unlock(ctx, resource.LockKey())
 }
 
 func (self *CustomResourceResource) ImportState(
@@ -4,6 +4,8 @@
 package provider
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -12,7 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
-func CustomResourceSchema() schema.Schema {
+func CustomResourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Custom Resource resource",
 		Attributes: map[string]schema.Attribute{
@@ -59,5 +61,8 @@ func CustomResourceSchema() schema.Schema {
 			"project_id": OptionalImmutableProjectIdSchema(),
 			"org_id":     ComputedOrganizationIdSchema(),
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": TimeoutsSchema(ctx),
+		},
 	}
 }
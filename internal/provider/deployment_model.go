@@ -0,0 +1,366 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Action of the day-2 request updating the inputs of a deployment.
+const DEPLOYMENT_UPDATE_ACTION = "Deployment.Update"
+
+// Status of the requests that are finished (the others are pending or in progress).
+const DEPLOYMENT_REQUEST_SUCCESSFUL = "SUCCESSFUL"
+
+var DEPLOYMENT_REQUEST_FINAL_STATUSES = []string{
+	DEPLOYMENT_REQUEST_SUCCESSFUL, "FAILED", "ABORTED", "APPROVAL_REJECTED",
+}
+
+// DeploymentModel describes the resource data model.
+type DeploymentModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	ProjectId   types.String `tfsdk:"project_id"`
+
+	// Either a catalog item or a cloud template is requested
+	CatalogItemId        types.String `tfsdk:"catalog_item_id"`
+	CatalogItemVersion   types.String `tfsdk:"catalog_item_version"`
+	CloudTemplateId      types.String `tfsdk:"cloud_template_id"`
+	CloudTemplateVersion types.String `tfsdk:"cloud_template_version"`
+
+	Inputs jsontypes.Normalized `tfsdk:"inputs"`
+	Reason types.String         `tfsdk:"reason"`
+
+	Status types.String `tfsdk:"status"`
+
+	// Of type DeploymentResourceModel
+	Resources types.List `tfsdk:"resources"`
+
+	Outputs jsontypes.Normalized `tfsdk:"outputs"`
+
+	CreatedAt timetypes.RFC3339 `tfsdk:"created_at"`
+	CreatedBy types.String      `tfsdk:"created_by"`
+
+	OrgId types.String `tfsdk:"org_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// DeploymentAPIModel describes the resource API model.
+type DeploymentAPIModel struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ProjectId   string `json:"projectId"`
+
+	CatalogItemId      string `json:"catalogItemId,omitempty"`
+	CatalogItemVersion string `json:"catalogItemVersion,omitempty"`
+	BlueprintId        string `json:"blueprintId,omitempty"`
+	BlueprintVersion   string `json:"blueprintVersion,omitempty"`
+
+	Inputs  map[string]any `json:"inputs"`
+	Outputs map[string]any `json:"outputs,omitempty"`
+
+	Status      string                     `json:"status"`
+	LastRequest *DeploymentRequestAPIModel `json:"lastRequest,omitempty"`
+
+	Resources []DeploymentResourceAPIModel `json:"resources"`
+
+	CreatedAt string `json:"createdAt"`
+	CreatedBy string `json:"createdBy"`
+
+	OrgId string `json:"orgId"`
+}
+
+// DeploymentRequestAPIModel describes a request (e.g. create or day-2 update) of a deployment.
+type DeploymentRequestAPIModel struct {
+	Id      string `json:"id"`
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Details string `json:"details"`
+}
+
+// DeploymentCatalogItemRequestAPIModel describes the request of a catalog item.
+type DeploymentCatalogItemRequestAPIModel struct {
+	DeploymentName string         `json:"deploymentName"`
+	ProjectId      string         `json:"projectId"`
+	Version        string         `json:"version,omitempty"`
+	Inputs         map[string]any `json:"inputs"`
+	Reason         string         `json:"reason,omitempty"`
+}
+
+// DeploymentCatalogItemResponseAPIModel describes the deployment(s) of a catalog item request.
+type DeploymentCatalogItemResponseAPIModel struct {
+	DeploymentId   string `json:"deploymentId"`
+	DeploymentName string `json:"deploymentName"`
+}
+
+// DeploymentCloudTemplateRequestAPIModel describes the request of a cloud template.
+type DeploymentCloudTemplateRequestAPIModel struct {
+	BlueprintId      string         `json:"blueprintId"`
+	BlueprintVersion string         `json:"blueprintVersion,omitempty"`
+	DeploymentName   string         `json:"deploymentName"`
+	Description      string         `json:"description"`
+	ProjectId        string         `json:"projectId"`
+	Inputs           map[string]any `json:"inputs"`
+	Reason           string         `json:"reason,omitempty"`
+}
+
+// DeploymentCloudTemplateResponseAPIModel describes the response of a cloud template request.
+type DeploymentCloudTemplateResponseAPIModel struct {
+	Id           string `json:"id"`
+	DeploymentId string `json:"deploymentId"`
+	Status       string `json:"status"`
+}
+
+// DeploymentUpdateAPIModel describes the changes of the name and description of a deployment.
+type DeploymentUpdateAPIModel struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// DeploymentActionRequestAPIModel describes a day-2 request (e.g. updating the inputs).
+type DeploymentActionRequestAPIModel struct {
+	ActionId string         `json:"actionId"`
+	Inputs   map[string]any `json:"inputs"`
+	Reason   string         `json:"reason,omitempty"`
+}
+
+func (self DeploymentModel) String() string {
+	return fmt.Sprintf(
+		"Deployment %s (%s)",
+		self.Id.ValueString(),
+		self.Name.ValueString())
+}
+
+// Return an appropriate key that can be used for naming mutexes.
+// Create: Identifier can be used to prevent concurrent creation of deployments.
+// Read Update Delete: Identifier can be used to prevent concurrent modifications on the instance.
+func (self DeploymentModel) LockKey() string {
+	return "deployment-" + self.Id.ValueString()
+}
+
+func (self DeploymentModel) CreatePath() string {
+	if self.IsCatalogItem() {
+		return "catalog/api/items/" + self.CatalogItemId.ValueString() + "/request"
+	}
+	return "blueprint/api/blueprint-requests"
+}
+
+func (self DeploymentModel) ReadPath() string {
+	return self.DeletePath() + "?expand=resources"
+}
+
+func (self DeploymentModel) UpdatePath() string {
+	return self.DeletePath()
+}
+
+// Day-2 requests (e.g. updating the inputs) are made on the deployment.
+func (self DeploymentModel) RequestPath() string {
+	return self.DeletePath() + "/requests"
+}
+
+// Requests are then retrieved by identifier (to be polled until finished).
+func (self DeploymentModel) RequestReadPath(requestId string) string {
+	return "deployment/api/requests/" + requestId
+}
+
+func (self DeploymentModel) DeletePath() string {
+	return "deployment/api/deployments/" + self.Id.ValueString()
+}
+
+// Return true if the deployment is requested from a catalog item (instead of a cloud template).
+func (self DeploymentModel) IsCatalogItem() bool {
+	return len(self.CatalogItemId.ValueString()) > 0
+}
+
+func (self *DeploymentModel) FromAPI(
+	ctx context.Context,
+	raw DeploymentAPIModel,
+) diag.Diagnostics {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Description = types.StringValue(raw.Description)
+	self.ProjectId = types.StringValue(raw.ProjectId)
+	self.CatalogItemId = StringOrNullValue(raw.CatalogItemId)
+	self.CatalogItemVersion = StringOrNullValue(raw.CatalogItemVersion)
+	self.CloudTemplateId = StringOrNullValue(raw.BlueprintId)
+	self.CloudTemplateVersion = StringOrNullValue(raw.BlueprintVersion)
+	self.Status = types.StringValue(raw.Status)
+	self.CreatedBy = types.StringValue(raw.CreatedBy)
+	self.OrgId = types.StringValue(raw.OrgId)
+
+	// A deployment requested from a catalog item of type cloud template is related to both
+	if self.IsCatalogItem() {
+		self.CloudTemplateId = types.StringNull()
+		self.CloudTemplateVersion = types.StringNull()
+	}
+
+	diags := self.InputsFromAPI(raw.Inputs)
+
+	var someDiags diag.Diagnostics
+	self.Outputs, someDiags = DeploymentJSONFromAny(self.String()+" outputs", raw.Outputs)
+	diags.Append(someDiags...)
+
+	self.CreatedAt, someDiags = timetypes.NewRFC3339Value(raw.CreatedAt)
+	diags.Append(someDiags...)
+
+	// Convert raw resources to a ListValue of objects
+	resources := []DeploymentResourceModel{}
+	for _, resourceRaw := range raw.Resources {
+		resource := DeploymentResourceModel{}
+		diags.Append(resource.FromAPI(resourceRaw)...)
+		resources = append(resources, resource)
+	}
+
+	attrs := types.ObjectType{AttrTypes: DeploymentResourceModel{}.AttributeTypes()}
+	self.Resources, someDiags = types.ListValueFrom(ctx, attrs, resources)
+	diags.Append(someDiags...)
+
+	return diags
+}
+
+// Refresh the inputs declared in the state (left null if none, e.g. when importing).
+// The other inputs (e.g. defaults of the cloud template) are ignored.
+func (self *DeploymentModel) InputsFromAPI(raw map[string]any) diag.Diagnostics {
+	name := self.String() + " inputs"
+	if self.Inputs.IsNull() || self.Inputs.IsUnknown() {
+		return diag.Diagnostics{}
+	}
+
+	inputs, diags := self.InputsToAPI()
+	if diags.HasError() {
+		return diags
+	}
+	for key := range inputs {
+		if value, found := raw[key]; found {
+			inputs[key] = value
+		}
+	}
+
+	var someDiags diag.Diagnostics
+	self.Inputs, someDiags = DeploymentJSONFromAny(name, inputs)
+	diags.Append(someDiags...)
+	return diags
+}
+
+func (self DeploymentModel) InputsToAPI() (map[string]any, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	inputs := map[string]any{}
+	if self.Inputs.IsNull() || self.Inputs.IsUnknown() {
+		return inputs, diags
+	}
+	if err := json.Unmarshal([]byte(self.Inputs.ValueString()), &inputs); err != nil {
+		diags.AddError(
+			"Configuration error",
+			fmt.Sprintf(
+				"Unable to JSON decode %s inputs (must be an object), got error: %s",
+				self.String(), err))
+	}
+	return inputs, diags
+}
+
+func (self DeploymentModel) ToCatalogItemRequestAPI() (
+	DeploymentCatalogItemRequestAPIModel,
+	diag.Diagnostics,
+) {
+	inputs, diags := self.InputsToAPI()
+	return DeploymentCatalogItemRequestAPIModel{
+		DeploymentName: self.Name.ValueString(),
+		ProjectId:      self.ProjectId.ValueString(),
+		Version:        self.CatalogItemVersion.ValueString(),
+		Inputs:         inputs,
+		Reason:         self.Reason.ValueString(),
+	}, diags
+}
+
+func (self DeploymentModel) ToCloudTemplateRequestAPI() (
+	DeploymentCloudTemplateRequestAPIModel,
+	diag.Diagnostics,
+) {
+	inputs, diags := self.InputsToAPI()
+	return DeploymentCloudTemplateRequestAPIModel{
+		BlueprintId:      self.CloudTemplateId.ValueString(),
+		BlueprintVersion: self.CloudTemplateVersion.ValueString(),
+		DeploymentName:   self.Name.ValueString(),
+		Description:      CleanString(self.Description.ValueString()),
+		ProjectId:        self.ProjectId.ValueString(),
+		Inputs:           inputs,
+		Reason:           self.Reason.ValueString(),
+	}, diags
+}
+
+func (self DeploymentModel) ToUpdateAPI() DeploymentUpdateAPIModel {
+	return DeploymentUpdateAPIModel{
+		Name:        self.Name.ValueString(),
+		Description: CleanString(self.Description.ValueString()),
+	}
+}
+
+func (self DeploymentModel) ToUpdateInputsAPI() (
+	DeploymentActionRequestAPIModel,
+	diag.Diagnostics,
+) {
+	inputs, diags := self.InputsToAPI()
+	return DeploymentActionRequestAPIModel{
+		ActionId: DEPLOYMENT_UPDATE_ACTION,
+		Inputs:   inputs,
+		Reason:   self.Reason.ValueString(),
+	}, diags
+}
+
+// Utils -------------------------------------------------------------------------------------------
+
+// Return true if a request (create, update or delete) is in progress.
+func (self DeploymentAPIModel) IsInProgress() bool {
+	return strings.HasSuffix(self.Status, "_INPROGRESS")
+}
+
+// Return true if the last request (create, update or delete) has failed.
+func (self DeploymentAPIModel) IsFailed() bool {
+	return strings.HasSuffix(self.Status, "_FAILED")
+}
+
+// Return the details of the last request (e.g. why it failed).
+func (self DeploymentAPIModel) LastRequestDetails() string {
+	if self.LastRequest == nil {
+		return "no details"
+	}
+	return self.LastRequest.DetailsOrDefault()
+}
+
+// Return true if the request is finished, either successfully or not (e.g. rejected).
+func (self DeploymentRequestAPIModel) IsFinished() bool {
+	return slices.Contains(DEPLOYMENT_REQUEST_FINAL_STATUSES, self.Status)
+}
+
+// Return the details of the request (e.g. why it failed).
+func (self DeploymentRequestAPIModel) DetailsOrDefault() string {
+	if len(self.Details) == 0 {
+		return "no details"
+	}
+	return self.Details
+}
+
+// Convert inputs or outputs to a JSON encoded attribute, an empty object if there is none.
+func DeploymentJSONFromAny(name string, value map[string]any) (
+	jsontypes.Normalized,
+	diag.Diagnostics,
+) {
+	if value == nil {
+		value = map[string]any{}
+	}
+	return JSONNormalizedFromAny(name, value)
+}
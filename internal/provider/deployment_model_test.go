@@ -0,0 +1,38 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestDeploymentModelInputsFromAPI(t *testing.T) {
+	raw := map[string]any{"flavor": "large", "count": 2.0, "image": "ubuntu"}
+	cases := []struct {
+		name     string
+		inputs   jsontypes.Normalized
+		expected string
+	}{
+		{"declared", jsontypes.NewNormalizedValue(`{"flavor":"small"}`), `{"flavor":"large"}`},
+		{"declared (not returned)", jsontypes.NewNormalizedValue(`{"size":1}`), `{"size":1}`},
+		{"none", jsontypes.NewNormalizedValue(`{}`), `{}`},
+		{"imported", jsontypes.NewNormalizedNull(), ``},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			deployment := DeploymentModel{Inputs: tc.inputs}
+			CheckDiagnostics(t, deployment.InputsFromAPI(raw), "", "")
+			CheckEqual(t, deployment.Inputs.ValueString(), tc.expected)
+		})
+	}
+}
+
+func TestDeploymentModelInputsToAPI_Invalid(t *testing.T) {
+	deployment := DeploymentModel{Inputs: jsontypes.NewNormalizedValue(`["flavor"]`)}
+	_, diags := deployment.InputsToAPI()
+	CheckDiagnostics(t, diags, "", "must be an object")
+}
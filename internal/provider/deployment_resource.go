@@ -0,0 +1,498 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DeploymentResource{}
+var _ resource.ResourceWithConfigValidators = &DeploymentResource{}
+var _ resource.ResourceWithImportState = &DeploymentResource{}
+
+func NewDeploymentResource() resource.Resource {
+	return &DeploymentResource{}
+}
+
+// DeploymentResource defines the resource implementation.
+type DeploymentResource struct {
+	client *AriaClient
+}
+
+func (self *DeploymentResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (self *DeploymentResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = DeploymentSchema(ctx)
+}
+
+func (self *DeploymentResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	self.client = GetResourceClient(ctx, req, resp)
+}
+
+func (self DeploymentResource) ConfigValidators(
+	ctx context.Context,
+) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("catalog_item_id"),
+			path.MatchRoot("cloud_template_id"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("catalog_item_version"),
+			path.MatchRoot("cloud_template_id"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("cloud_template_version"),
+			path.MatchRoot("catalog_item_id"),
+		),
+	}
+}
+
+func (self *DeploymentResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	// Read Terraform plan data into the model
+	var deployment DeploymentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &deployment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, timeoutDiags := deployment.Timeouts.Create(ctx, DEFAULT_DEPLOYMENT_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Request the catalog item or the cloud template
+	var deploymentId string
+	if deployment.IsCatalogItem() {
+		deploymentId = self.RequestCatalogItem(ctx, deployment, &resp.Diagnostics)
+	} else {
+		deploymentId = self.RequestCloudTemplate(ctx, deployment, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the deployment before waiting, a deployment failing to be created (or timing out) is
+	// then tainted instead of being orphaned
+	plan := deployment
+	deployment.Id = types.StringValue(deploymentId)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &deployment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for the deployment to be created then save it (even if failed)
+	resp.Diagnostics.Append(self.WaitDeployment(ctx, &deployment, "created", true)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &deployment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The description cannot be set while requesting a catalog item
+	if !deployment.Description.Equal(plan.Description) {
+		resp.Diagnostics.Append(self.UpdateDeployment(ctx, &deployment, plan)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &deployment)...)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", deployment.String()))
+}
+
+func (self *DeploymentResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	// Read Terraform prior state data into the model
+	var deployment DeploymentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &deployment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var deploymentFromAPI DeploymentAPIModel
+	found, _, readDiags := self.client.ReadIt(ctx, &deployment, &deploymentFromAPI)
+	resp.Diagnostics.Append(readDiags...)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated deployment into Terraform state
+	resp.Diagnostics.Append(deployment.FromAPI(ctx, deploymentFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &deployment)...)
+}
+
+func (self *DeploymentResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	// Read Terraform plan and state data into the models
+	var deployment DeploymentModel
+	var state DeploymentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &deployment)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, timeoutDiags := deployment.Timeouts.Update(ctx, DEFAULT_DEPLOYMENT_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	plan := deployment
+	if !deployment.Name.Equal(state.Name) || !deployment.Description.Equal(state.Description) {
+		resp.Diagnostics.Append(self.UpdateDeployment(ctx, &deployment, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Update the inputs with a day-2 request (if declared)
+	inputsEqual := deployment.Inputs.IsNull() || deployment.Inputs.Equal(state.Inputs)
+	if !inputsEqual && !state.Inputs.IsNull() {
+		var someDiags diag.Diagnostics
+		inputsEqual, someDiags = deployment.Inputs.StringSemanticEquals(ctx, state.Inputs)
+		resp.Diagnostics.Append(someDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if !inputsEqual {
+		requestToAPI, someDiags := deployment.ToUpdateInputsAPI()
+		resp.Diagnostics.Append(someDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var requestFromAPI DeploymentRequestAPIModel
+		path := deployment.RequestPath()
+		response, err := self.client.R(ctx, path).
+			SetBody(requestToAPI).
+			SetResult(&requestFromAPI).
+			Post(path)
+		err = self.client.HandleAPIResponse(response, err, []int{200})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client error",
+				fmt.Sprintf(
+					"Unable to update inputs of %s, got error: %s",
+					deployment.String(), err))
+			return
+		}
+
+		// The status of the deployment may not reflect the request yet, the request is polled
+		resp.Diagnostics.Append(self.WaitRequest(ctx, deployment, requestFromAPI, "updated")...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Refresh the deployment (and the computed attributes), its status is only checked if a request
+	// has been submitted (a former failed request must not fail renaming the deployment)
+	if inputsEqual {
+		resp.Diagnostics.Append(self.RefreshDeployment(ctx, &deployment)...)
+	} else {
+		resp.Diagnostics.Append(self.WaitDeployment(ctx, &deployment, "updated", false)...)
+	}
+	if !deployment.Status.IsUnknown() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &deployment)...)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", deployment.String()))
+}
+
+func (self *DeploymentResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// Read Terraform prior state data into the model
+	var deployment DeploymentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &deployment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, timeoutDiags := deployment.Timeouts.Delete(ctx, DEFAULT_DEPLOYMENT_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(self.client.DeleteIt(ctx, &deployment)...)
+}
+
+func (self *DeploymentResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// -------------------------------------------------------------------------------------------------
+
+// Request the catalog item and return the identifier of the deployment.
+func (self *DeploymentResource) RequestCatalogItem(
+	ctx context.Context,
+	deployment DeploymentModel,
+	diags *diag.Diagnostics,
+) string {
+	requestToAPI, someDiags := deployment.ToCatalogItemRequestAPI()
+	diags.Append(someDiags...)
+	if diags.HasError() {
+		return ""
+	}
+
+	var deploymentsFromAPI []DeploymentCatalogItemResponseAPIModel
+	path := deployment.CreatePath()
+	response, err := self.client.R(ctx, path).
+		SetBody(requestToAPI).
+		SetResult(&deploymentsFromAPI).
+		Post(path)
+	err = self.client.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		diags.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to create %s, got error: %s", deployment.String(), err))
+		return ""
+	}
+
+	if len(deploymentsFromAPI) != 1 {
+		diags.AddError(
+			"Client error",
+			fmt.Sprintf(
+				"Unable to create %s, expected 1 deployment, got %d",
+				deployment.String(), len(deploymentsFromAPI)))
+		return ""
+	}
+	return deploymentsFromAPI[0].DeploymentId
+}
+
+// Request the cloud template and return the identifier of the deployment.
+func (self *DeploymentResource) RequestCloudTemplate(
+	ctx context.Context,
+	deployment DeploymentModel,
+	diags *diag.Diagnostics,
+) string {
+	requestToAPI, someDiags := deployment.ToCloudTemplateRequestAPI()
+	diags.Append(someDiags...)
+	if diags.HasError() {
+		return ""
+	}
+
+	var requestFromAPI DeploymentCloudTemplateResponseAPIModel
+	path := deployment.CreatePath()
+	response, err := self.client.R(ctx, path).
+		SetBody(requestToAPI).
+		SetResult(&requestFromAPI).
+		Post(path)
+	err = self.client.HandleAPIResponse(response, err, []int{202})
+	if err != nil {
+		diags.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to create %s, got error: %s", deployment.String(), err))
+		return ""
+	}
+	return requestFromAPI.DeploymentId
+}
+
+// Update the name and description of the deployment (as planned).
+func (self *DeploymentResource) UpdateDeployment(
+	ctx context.Context,
+	deployment *DeploymentModel,
+	plan DeploymentModel,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	path := deployment.UpdatePath()
+	response, err := self.client.R(ctx, path).SetBody(plan.ToUpdateAPI()).Patch(path)
+	err = self.client.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		diags.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to update %s, got error: %s", deployment.String(), err))
+		return diags
+	}
+	deployment.Name = plan.Name
+	deployment.Description = plan.Description
+	return diags
+}
+
+// Refresh the deployment (and the computed attributes) whatever its status.
+func (self *DeploymentResource) RefreshDeployment(
+	ctx context.Context,
+	deployment *DeploymentModel,
+) diag.Diagnostics {
+	var deploymentFromAPI DeploymentAPIModel
+	found, _, diags := self.client.ReadIt(ctx, deployment, &deploymentFromAPI)
+	if !found {
+		diags.AddError(
+			"Client error",
+			fmt.Sprintf("%s has vanished while being updated.", deployment.String()))
+	}
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(deployment.FromAPI(ctx, deploymentFromAPI)...)
+	return diags
+}
+
+// Poll the request (e.g. a day-2 update) until finished, the request is failing unless successful.
+func (self *DeploymentResource) WaitRequest(
+	ctx context.Context,
+	deployment DeploymentModel,
+	request DeploymentRequestAPIModel,
+	action string,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	name := deployment.String()
+	if len(request.Id) == 0 {
+		diags.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to wait for %s to be %s, request has no identifier.", name, action))
+		return diags
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Wait request %s of %s to be finished...", request.Id, name))
+
+	// Poll for the request to be finished until the operation times out (see timeouts)
+	path := deployment.RequestReadPath(request.Id)
+	interval := self.client.GetPollInterval(ctx, time.Duration(30)*time.Second)
+	for attempt := 0; !request.IsFinished(); attempt++ {
+		if attempt > 0 {
+			if err := Sleep(ctx, interval); err != nil {
+				diags.Append(InterruptedDiagnostics(
+					err, fmt.Sprintf("waiting for %s to be %s", name, action))...)
+				return diags
+			}
+		}
+		tflog.Debug(ctx, fmt.Sprintf(
+			"Poll %d - Check request %s of %s is finished...", attempt+1, request.Id, name))
+
+		found, _, someDiags := self.client.ReadIt(ctx, &deployment, &request, path)
+		diags.Append(someDiags...)
+		if !found {
+			diags.AddError(
+				"Client error",
+				fmt.Sprintf("Request %s of %s has vanished while waiting.", request.Id, name))
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if request.Status != DEPLOYMENT_REQUEST_SUCCESSFUL {
+		diags.AddError(
+			"Client error",
+			fmt.Sprintf(
+				"%s has not been %s (request %s), got error: %s",
+				name, action, request.Status, request.DetailsOrDefault()))
+	}
+	return diags
+}
+
+// Poll the deployment until its request (e.g. create) is finished, the deployment is refreshed.
+// The request is failing if the deployment is (e.g. CREATE_FAILED).
+// A deployment just requested may not be found yet, it is then polled until the deadline.
+func (self *DeploymentResource) WaitDeployment(
+	ctx context.Context,
+	deployment *DeploymentModel,
+	action string,
+	justRequested bool,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	name := deployment.String()
+	tflog.Debug(ctx, fmt.Sprintf("Wait %s to be %s...", name, action))
+
+	// Poll for the request to be finished until the operation times out (see timeouts)
+	interval := self.client.GetPollInterval(ctx, time.Duration(30)*time.Second)
+	for attempt := 0; ; attempt++ {
+		tflog.Debug(ctx, fmt.Sprintf("Poll %d - Check %s is %s...", attempt+1, name, action))
+
+		var deploymentFromAPI DeploymentAPIModel
+		found, _, someDiags := self.client.ReadIt(ctx, deployment, &deploymentFromAPI)
+		diags.Append(someDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		if !found && !justRequested {
+			diags.AddError(
+				"Client error",
+				fmt.Sprintf("%s has vanished while waiting to be %s.", name, action))
+			return diags
+		}
+
+		if found {
+			// Update deployment from API
+			diags.Append(deployment.FromAPI(ctx, deploymentFromAPI)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if deploymentFromAPI.IsFailed() {
+				diags.AddError(
+					"Client error",
+					fmt.Sprintf(
+						"%s has not been %s (%s), got error: %s",
+						deployment.String(), action, deploymentFromAPI.Status,
+						deploymentFromAPI.LastRequestDetails()))
+				return diags
+			}
+
+			if !deploymentFromAPI.IsInProgress() {
+				return diags
+			}
+		} else {
+			tflog.Debug(ctx, fmt.Sprintf("%s is not visible yet...", name))
+		}
+
+		if err := Sleep(ctx, interval); err != nil {
+			diags.Append(InterruptedDiagnostics(
+				err, fmt.Sprintf("waiting for %s to be %s", name, action))...)
+			return diags
+		}
+	}
+}
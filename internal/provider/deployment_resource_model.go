@@ -0,0 +1,55 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DeploymentResourceModel describes the resource data model.
+type DeploymentResourceModel struct {
+	Id         types.String         `tfsdk:"id"`
+	Name       types.String         `tfsdk:"name"`
+	Type       types.String         `tfsdk:"type"`
+	State      types.String         `tfsdk:"state"`
+	Properties jsontypes.Normalized `tfsdk:"properties"`
+}
+
+// DeploymentResourceAPIModel describes the resource API model.
+type DeploymentResourceAPIModel struct {
+	Id         string         `json:"id"`
+	Name       string         `json:"name"`
+	Type       string         `json:"type"`
+	State      string         `json:"state"`
+	Properties map[string]any `json:"properties"`
+}
+
+func (self DeploymentResourceModel) String() string {
+	return "Deployment Resource " + self.Name.ValueString()
+}
+
+func (self *DeploymentResourceModel) FromAPI(raw DeploymentResourceAPIModel) diag.Diagnostics {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Type = types.StringValue(raw.Type)
+	self.State = types.StringValue(raw.State)
+
+	var diags diag.Diagnostics
+	self.Properties, diags = DeploymentJSONFromAny(self.String()+" properties", raw.Properties)
+	return diags
+}
+
+// Used to convert structure to a types.Object.
+func (self DeploymentResourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"name":       types.StringType,
+		"type":       types.StringType,
+		"state":      types.StringType,
+		"properties": jsontypes.NormalizedType{},
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const DEPLOYMENT_CLOUD_TEMPLATE_CONFIG = `
+variable "test_project_id" {
+  description = "Project where to generate test resources."
+  type        = string
+}
+
+resource "aria_cloud_template_v1" "test" {
+  name              = "ARIA_PROVIDER_TEST_DEPLOYMENT"
+  description       = "Temporary cloud template generated by Aria provider's acceptance tests."
+  project_id        = var.test_project_id
+  request_scope_org = false
+
+  content = <<-EOT
+    formatVersion: 1
+    inputs:
+      flavor:
+        type: string
+        enum: [small, medium]
+        default: small
+      count:
+        type: integer
+        default: 1
+    resources:
+      Machine_1:
+        type: Cloud.vSphere.Machine
+        properties:
+          image: ubuntu
+          flavor: $${input.flavor}
+    outputs:
+      flavor:
+        value: $${input.flavor}
+  EOT
+}
+`
+
+func TestAccDeploymentResource_CloudTemplate(t *testing.T) {
+	config := func(name string, flavor string) string {
+		return DEPLOYMENT_CLOUD_TEMPLATE_CONFIG + fmt.Sprintf(`
+resource "aria_deployment" "test" {
+  name              = "%s"
+  description       = "Temporary deployment generated by Aria provider's acceptance tests."
+  project_id        = var.test_project_id
+  cloud_template_id = aria_cloud_template_v1.test.id
+  reason            = "Smoke testing the cloud template"
+
+  inputs = jsonencode({
+    flavor = "%s"
+  })
+
+  timeouts {
+    create = "1m"
+    update = "1m"
+    delete = "1m"
+  }
+}
+`, name, flavor)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("ARIA_PROVIDER_TEST_DEPLOYMENT", "small"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aria_deployment.test", "id"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "name", "ARIA_PROVIDER_TEST_DEPLOYMENT"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "description", "Temporary deployment generated by Aria provider's acceptance tests."),
+					resource.TestCheckResourceAttrPair(
+						"aria_deployment.test", "cloud_template_id",
+						"aria_cloud_template_v1.test", "id",
+					),
+					resource.TestCheckNoResourceAttr("aria_deployment.test", "cloud_template_version"),
+					resource.TestCheckNoResourceAttr("aria_deployment.test", "catalog_item_id"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "inputs", `{"flavor":"small"}`),
+					resource.TestCheckResourceAttr("aria_deployment.test", "status", "CREATE_SUCCESSFUL"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "resources.#", "1"),
+					resource.TestCheckResourceAttrSet("aria_deployment.test", "resources.0.id"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "resources.0.name", "Machine_1"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "resources.0.type", "Cloud.vSphere.Machine"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "resources.0.state", "OK"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "resources.0.properties", `{"flavor":"small","image":"ubuntu"}`),
+					resource.TestCheckResourceAttr("aria_deployment.test", "outputs", `{"flavor":{"value":"small"}}`),
+					resource.TestCheckResourceAttrSet("aria_deployment.test", "created_at"),
+					resource.TestCheckResourceAttrSet("aria_deployment.test", "created_by"),
+					resource.TestCheckResourceAttrSet("aria_deployment.test", "org_id"),
+				),
+			},
+			// Update testing (day-2 update of the inputs and renaming)
+			{
+				Config: config("ARIA_PROVIDER_TEST_DEPLOYMENT_RENAMED", "medium"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_deployment.test", "name", "ARIA_PROVIDER_TEST_DEPLOYMENT_RENAMED"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "inputs", `{"flavor":"medium"}`),
+					resource.TestCheckResourceAttr("aria_deployment.test", "status", "UPDATE_SUCCESSFUL"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "resources.#", "1"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "resources.0.properties", `{"flavor":"medium","image":"ubuntu"}`),
+					resource.TestCheckResourceAttr("aria_deployment.test", "outputs", `{"flavor":{"value":"medium"}}`),
+				),
+			},
+			// ImportState testing (the inputs are not imported)
+			{
+				ResourceName:            "aria_deployment.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"inputs", "reason", "timeouts"},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if inputs, found := states[0].Attributes["inputs"]; found {
+						return fmt.Errorf("Unexpected imported inputs %s", inputs)
+					}
+					return nil
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDeploymentResource_CatalogItem(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+variable "test_project_id" {
+  description = "Project where to generate test resources."
+  type        = string
+}
+
+variable "test_catalog_item_id" {
+  description = "Catalog item to request."
+  type        = string
+}
+
+resource "aria_deployment" "test" {
+  name            = "ARIA_PROVIDER_TEST_DEPLOYMENT_CATALOG_ITEM"
+  description     = "Temporary deployment generated by Aria provider's acceptance tests."
+  project_id      = var.test_project_id
+  catalog_item_id = var.test_catalog_item_id
+
+  timeouts {
+    create = "1m"
+    delete = "1m"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aria_deployment.test", "id"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "name", "ARIA_PROVIDER_TEST_DEPLOYMENT_CATALOG_ITEM"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "description", "Temporary deployment generated by Aria provider's acceptance tests."),
+					resource.TestCheckResourceAttrSet("aria_deployment.test", "catalog_item_id"),
+					resource.TestCheckNoResourceAttr("aria_deployment.test", "cloud_template_id"),
+					resource.TestCheckNoResourceAttr("aria_deployment.test", "inputs"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "status", "CREATE_SUCCESSFUL"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDeploymentResource_FailedUpdate(t *testing.T) {
+	config := func(name string, flavor string) string {
+		return DEPLOYMENT_CLOUD_TEMPLATE_CONFIG + fmt.Sprintf(`
+resource "aria_deployment" "test" {
+  name              = "%s"
+  description       = "Temporary deployment generated by Aria provider's acceptance tests."
+  project_id        = var.test_project_id
+  cloud_template_id = aria_cloud_template_v1.test.id
+
+  inputs = jsonencode({
+    flavor = "%s"
+  })
+
+  timeouts {
+    create = "1m"
+    update = "1m"
+    delete = "1m"
+  }
+}
+`, name, flavor)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("ARIA_PROVIDER_TEST_DEPLOYMENT_FAILED_UPDATE", "small"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_deployment.test", "status", "CREATE_SUCCESSFUL"),
+				),
+			},
+			// Day-2 update failing (an input not allowed)
+			{
+				Config:      config("ARIA_PROVIDER_TEST_DEPLOYMENT_FAILED_UPDATE", "large"),
+				ExpectError: regexp.MustCompile(`has\s+not\s+been\s+updated\s+\(request\s+FAILED\)`),
+			},
+			// Renaming is not failing because of the former request
+			{
+				Config: config("ARIA_PROVIDER_TEST_DEPLOYMENT_FAILED_UPDATE_RENAMED", "large"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_deployment.test", "name", "ARIA_PROVIDER_TEST_DEPLOYMENT_FAILED_UPDATE_RENAMED"),
+					resource.TestCheckResourceAttr("aria_deployment.test", "status", "UPDATE_FAILED"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDeploymentResource_Errors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Both a catalog item and a cloud template
+			{
+				Config: `
+resource "aria_deployment" "test" {
+  name              = "ARIA_PROVIDER_TEST_DEPLOYMENT"
+  project_id        = "some-project"
+  catalog_item_id   = "some-catalog-item"
+  cloud_template_id = "some-cloud-template"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid\s+Attribute\s+Combination`),
+			},
+			// Deployment failing (an invalid cloud template), the deployment is tainted
+			{
+				Config: DEPLOYMENT_CLOUD_TEMPLATE_CONFIG + `
+resource "aria_cloud_template_v1" "invalid" {
+  name              = "ARIA_PROVIDER_TEST_DEPLOYMENT_INVALID"
+  description       = "Temporary cloud template generated by Aria provider's acceptance tests."
+  project_id        = var.test_project_id
+  request_scope_org = false
+
+  content = <<-EOT
+    formatVersion: 1
+    inputs: {}
+    resources:
+      Machine_1:
+        type: Cloud.vSphere.Machine
+  EOT
+}
+
+resource "aria_deployment" "test" {
+  name              = "ARIA_PROVIDER_TEST_DEPLOYMENT_INVALID"
+  project_id        = var.test_project_id
+  cloud_template_id = aria_cloud_template_v1.invalid.id
+
+  timeouts {
+    create = "1m"
+    delete = "1m"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`has\s+not\s+been\s+created\s+\(CREATE_FAILED\)`),
+			},
+		},
+	})
+}
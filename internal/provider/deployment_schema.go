@@ -0,0 +1,147 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func DeploymentSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: strings.Join([]string{
+			"Deployment resource, requesting a catalog item or a cloud template (e.g. for smoke " +
+				"testing the catalog).",
+			"",
+			"The provider waits for the requests (create, update and delete) to be finished, up " +
+				"to the matching timeout (60 minutes by default, see `timeouts`). " +
+				"Updating the `inputs` triggers a day-2 `Update` of the deployment.",
+		}, "\n"),
+		Attributes: map[string]schema.Attribute{
+			"id": ComputedIdentifierSchema(""),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Deployment name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Describe the deployment in few sentences",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"project_id": RequiredImmutableProjectIdSchema(),
+			"catalog_item_id": schema.StringAttribute{
+				MarkdownDescription: "Catalog item to request " +
+					"(either this or `cloud_template_id` must be set)" + IMMUTABLE,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"catalog_item_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the catalog item to request (defaults to the " +
+					"latest)" + IMMUTABLE,
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cloud_template_id": schema.StringAttribute{
+				MarkdownDescription: "Cloud template to request " +
+					"(either this or `catalog_item_id` must be set)" + IMMUTABLE,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cloud_template_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the cloud template to request (defaults to the " +
+					"current draft)" + IMMUTABLE,
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"inputs": schema.StringAttribute{
+				MarkdownDescription: "Inputs of the request (JSON encoded object, e.g. " +
+					"`jsonencode({ flavor = \"small\" })`). Only the declared inputs are " +
+					"refreshed from the deployment (none when imported).",
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Reason of the requests (create and update)",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status (e.g. `CREATE_SUCCESSFUL`)",
+				Computed:            true,
+			},
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "Resources of the deployment",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name (e.g. `Machine_1`)",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type (e.g. `Cloud.vSphere.Machine`)",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "State (e.g. `OK`)",
+							Computed:            true,
+						},
+						"properties": schema.StringAttribute{
+							MarkdownDescription: "Properties (JSON encoded)",
+							CustomType:          jsontypes.NormalizedType{},
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"outputs": schema.StringAttribute{
+				MarkdownDescription: "Outputs of the deployment (JSON encoded)",
+				CustomType:          jsontypes.NormalizedType{},
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp (RFC3339)",
+				CustomType:          timetypes.RFC3339Type{},
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "User who requested the deployment",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": ComputedOrganizationIdSchema(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": TimeoutsSchema(ctx),
+		},
+	}
+}
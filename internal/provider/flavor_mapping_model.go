@@ -0,0 +1,36 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FlavorMappingModel describes the resource data model.
+type FlavorMappingModel struct {
+	InstanceType types.String `tfsdk:"instance_type"`
+	CPUCount     types.Int32  `tfsdk:"cpu_count"`
+	MemoryInMB   types.Int32  `tfsdk:"memory_in_mb"`
+}
+
+// FlavorMappingAPIModel describes the resource API model.
+type FlavorMappingAPIModel struct {
+	Name       string `json:"name,omitempty"`
+	CPUCount   int32  `json:"cpuCount,omitempty"`
+	MemoryInMB int32  `json:"memoryInMB,omitempty"`
+}
+
+func (self *FlavorMappingModel) FromAPI(raw FlavorMappingAPIModel) {
+	self.InstanceType = types.StringValue(raw.Name)
+	self.CPUCount = types.Int32Value(raw.CPUCount)
+	self.MemoryInMB = types.Int32Value(raw.MemoryInMB)
+}
+
+func (self FlavorMappingModel) ToAPI() FlavorMappingAPIModel {
+	return FlavorMappingAPIModel{
+		Name:       self.InstanceType.ValueString(),
+		CPUCount:   self.CPUCount.ValueInt32(),
+		MemoryInMB: self.MemoryInMB.ValueInt32(),
+	}
+}
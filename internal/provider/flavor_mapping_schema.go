@@ -0,0 +1,44 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func FlavorMappingSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"instance_type": schema.StringAttribute{
+				MarkdownDescription: "Instance type of the public clouds (e.g. `t2.micro`), " +
+					"default is empty",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(""),
+			},
+			"cpu_count": schema.Int32Attribute{
+				MarkdownDescription: "Number of CPUs (e.g. vSphere), default is 0",
+				Computed:            true,
+				Optional:            true,
+				Default:             int32default.StaticInt32(0),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"memory_in_mb": schema.Int32Attribute{
+				MarkdownDescription: "Memory in MB (e.g. vSphere), default is 0",
+				Computed:            true,
+				Optional:            true,
+				Default:             int32default.StaticInt32(0),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FlavorProfileModel describes the resource data model.
+type FlavorProfileModel struct {
+	Id               types.String                  `tfsdk:"id"`
+	Name             types.String                  `tfsdk:"name"`
+	Description      types.String                  `tfsdk:"description"`
+	RegionId         types.String                  `tfsdk:"region_id"`
+	ExternalRegionId types.String                  `tfsdk:"external_region_id"`
+	CloudAccountId   types.String                  `tfsdk:"cloud_account_id"`
+	FlavorMapping    map[string]FlavorMappingModel `tfsdk:"flavor_mapping"`
+	OrgId            types.String                  `tfsdk:"org_id"`
+}
+
+// FlavorProfileAPIModel describes the resource API model.
+type FlavorProfileAPIModel struct {
+	Id               string `json:"id,omitempty"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	RegionId         string `json:"regionId"`
+	ExternalRegionId string `json:"externalRegionId,omitempty"`
+	CloudAccountId   string `json:"cloudAccountId,omitempty"`
+	OrgId            string `json:"orgId,omitempty"`
+
+	// The mapping is sent as flavorMapping and returned as flavorMappings.mapping
+	FlavorMapping  map[string]FlavorMappingAPIModel `json:"flavorMapping"`
+	FlavorMappings *FlavorMappingsAPIModel          `json:"flavorMappings,omitempty"`
+
+	// The region is returned as a link
+	Links *IaaSLinksAPIModel `json:"_links,omitempty"`
+}
+
+// FlavorMappingsAPIModel describes the mapping as returned by the API.
+type FlavorMappingsAPIModel struct {
+	Mapping map[string]FlavorMappingAPIModel `json:"mapping"`
+}
+
+func (self FlavorProfileModel) String() string {
+	return fmt.Sprintf(
+		"Flavor Profile %s (%s)",
+		self.Id.ValueString(),
+		self.Name.ValueString())
+}
+
+// Return an appropriate key that can be used for naming mutexes.
+// Create: Identifier can be used to prevent concurrent creation of flavor profiles.
+// Read Update Delete: Identifier can be used to prevent concurrent modifications on the instance.
+func (self FlavorProfileModel) LockKey() string {
+	return "flavor-profile-" + self.Id.ValueString()
+}
+
+func (self FlavorProfileModel) CreatePath() string {
+	return "iaas/api/flavor-profiles"
+}
+
+func (self FlavorProfileModel) ReadPath() string {
+	return "iaas/api/flavor-profiles/" + self.Id.ValueString()
+}
+
+func (self FlavorProfileModel) UpdatePath() string {
+	return self.ReadPath()
+}
+
+func (self FlavorProfileModel) DeletePath() string {
+	return self.ReadPath()
+}
+
+func (self *FlavorProfileModel) FromAPI(raw FlavorProfileAPIModel) {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Description = types.StringValue(raw.Description)
+	self.RegionId = types.StringValue(RegionIdFromAPI(raw.RegionId, raw.Links))
+	self.ExternalRegionId = types.StringValue(raw.ExternalRegionId)
+	self.CloudAccountId = types.StringValue(raw.CloudAccountId)
+	self.OrgId = types.StringValue(raw.OrgId)
+
+	self.FlavorMapping = map[string]FlavorMappingModel{}
+	if raw.FlavorMappings != nil {
+		for key, mappingRaw := range raw.FlavorMappings.Mapping {
+			mapping := FlavorMappingModel{}
+			mapping.FromAPI(mappingRaw)
+			self.FlavorMapping[key] = mapping
+		}
+	}
+}
+
+func (self FlavorProfileModel) ToAPI() FlavorProfileAPIModel {
+	mappingRaw := map[string]FlavorMappingAPIModel{}
+	for key, mapping := range self.FlavorMapping {
+		mappingRaw[key] = mapping.ToAPI()
+	}
+	return FlavorProfileAPIModel{
+		Id:            self.Id.ValueString(),
+		Name:          self.Name.ValueString(),
+		Description:   self.Description.ValueString(),
+		RegionId:      self.RegionId.ValueString(),
+		FlavorMapping: mappingRaw,
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFlavorProfileResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region of the flavor profile."
+  type        = string
+}
+
+resource "aria_flavor_profile" "test" {
+  name      = "ARIA_PROVIDER_TEST_FLAVOR_PROFILE"
+  region_id = var.test_region_id
+
+  flavor_mapping = {
+    small = {
+      cpu_count    = 1
+      memory_in_mb = 2048
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aria_flavor_profile.test", "id"),
+					resource.TestCheckResourceAttr("aria_flavor_profile.test", "name", "ARIA_PROVIDER_TEST_FLAVOR_PROFILE"),
+					resource.TestCheckResourceAttr("aria_flavor_profile.test", "description", ""),
+					resource.TestCheckResourceAttrSet("aria_flavor_profile.test", "external_region_id"),
+					resource.TestCheckResourceAttrSet("aria_flavor_profile.test", "cloud_account_id"),
+					resource.TestCheckResourceAttr("aria_flavor_profile.test", "flavor_mapping.%", "1"),
+					resource.TestCheckResourceAttr("aria_flavor_profile.test", "flavor_mapping.small.instance_type", ""),
+					resource.TestCheckResourceAttr("aria_flavor_profile.test", "flavor_mapping.small.cpu_count", "1"),
+					resource.TestCheckResourceAttr("aria_flavor_profile.test", "flavor_mapping.small.memory_in_mb", "2048"),
+					resource.TestCheckResourceAttrSet("aria_flavor_profile.test", "org_id"),
+				),
+			},
+			// Update testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region of the flavor profile."
+  type        = string
+}
+
+resource "aria_flavor_profile" "test" {
+  name        = "ARIA_PROVIDER_TEST_FLAVOR_PROFILE"
+  description = "Flavor profile managed by the acceptance tests."
+  region_id   = var.test_region_id
+
+  flavor_mapping = {
+    small = {
+      cpu_count    = 1
+      memory_in_mb = 1024
+    }
+    medium = {
+      cpu_count    = 2
+      memory_in_mb = 4096
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_flavor_profile.test", "description", "Flavor profile managed by the acceptance tests."),
+					resource.TestCheckResourceAttr("aria_flavor_profile.test", "flavor_mapping.%", "2"),
+					resource.TestCheckResourceAttr("aria_flavor_profile.test", "flavor_mapping.small.memory_in_mb", "1024"),
+					resource.TestCheckResourceAttr("aria_flavor_profile.test", "flavor_mapping.medium.cpu_count", "2"),
+					resource.TestCheckResourceAttr("aria_flavor_profile.test", "flavor_mapping.medium.memory_in_mb", "4096"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aria_flavor_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
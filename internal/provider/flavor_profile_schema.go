@@ -0,0 +1,74 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func FlavorProfileSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Flavor profile resource, maps the flavors (sizes) referenced by " +
+			"the cloud templates to the instance types (or CPU and memory) of a region",
+		Attributes: map[string]schema.Attribute{
+			"id": ComputedIdentifierSchema(""),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "A friendly name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Describe the resource in few sentences",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"region_id":          ProfileRegionIdSchema(),
+			"external_region_id": ProfileExternalRegionIdSchema(),
+			"cloud_account_id":   ProfileCloudAccountIdSchema(),
+			"flavor_mapping": schema.MapNestedAttribute{
+				MarkdownDescription: "Flavors of the region, the key is the name of the flavor " +
+					"referenced by the cloud templates (e.g. `small`)",
+				Required:     true,
+				NestedObject: FlavorMappingSchema(),
+			},
+			"org_id": ComputedOrganizationIdSchema(),
+		},
+	}
+}
+
+// Region of the profile (flavor or image profile), changing it will replace the profile.
+func ProfileRegionIdSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Region identifier (e.g. one of the `region_ids` of " +
+			"`aria_cloud_account_vsphere`)" + IMMUTABLE,
+		Required: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func ProfileExternalRegionIdSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Identifier of the region on the cloud (e.g. the datacenter of a " +
+			"vCenter)",
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func ProfileCloudAccountIdSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Identifier of the cloud account of the region",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
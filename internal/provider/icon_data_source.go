@@ -60,7 +60,7 @@ func (self *IconDataSource) Read(
 	}
 
 	path := icon.ReadPath()
-	response, err := self.client.R(path).Get(path)
+	response, err := self.client.R(ctx, path).Get(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -69,7 +69,7 @@ func (self *IconResource) Create(
 	lockKey := icon.LockKey()
 	path := icon.CreatePath()
 	self.client.Mutex.Lock(ctx, lockKey)
-	response, err := self.client.R(path).SetFile("file", icon.Path.ValueString()).Post(path)
+	response, err := self.client.R(ctx, path).SetFile("file", icon.Path.ValueString()).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{201})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -94,7 +94,7 @@ func (self *IconResource) Create(
 	// Read the icon to retrieve its content (duplicated code with read)
 
 	path = icon.ReadPath()
-	response, err = self.client.R(path).Get(path)
+	response, err = self.client.R(ctx, path).Get(path)
 	self.client.Mutex.Unlock(ctx, lockKey)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
@@ -125,7 +125,7 @@ func (self *IconResource) Read(
 
 	path := icon.ReadPath()
 	self.client.Mutex.RLock(ctx, icon.LockKey())
-	response, err := self.client.R(path).Get(path)
+	response, err := self.client.R(ctx, path).Get(path)
 	self.client.Mutex.RUnlock(ctx, icon.LockKey())
 
 	// Handle gracefully a resource that has vanished on the platform
@@ -175,7 +175,7 @@ func (self *IconResource) Delete(
 	resp.Diagnostics.Append(req.State.Get(ctx, &icon)...)
 	if !resp.Diagnostics.HasError() && !icon.KeepOnDestroy.ValueBool() {
 		self.client.Mutex.Lock(ctx, icon.LockKey())
-		resp.Diagnostics.Append(self.client.DeleteIt(&icon)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &icon)...)
 		self.client.Mutex.Unlock(ctx, icon.LockKey())
 	}
 }
@@ -0,0 +1,32 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ImageMappingModel describes the resource data model.
+type ImageMappingModel struct {
+	ImageName   types.String `tfsdk:"image_name"`
+	CloudConfig types.String `tfsdk:"cloud_config"`
+}
+
+// ImageMappingAPIModel describes the resource API model.
+type ImageMappingAPIModel struct {
+	Name        string `json:"name"`
+	CloudConfig string `json:"cloudConfig"`
+}
+
+func (self *ImageMappingModel) FromAPI(raw ImageMappingAPIModel) {
+	self.ImageName = types.StringValue(raw.Name)
+	self.CloudConfig = types.StringValue(raw.CloudConfig)
+}
+
+func (self ImageMappingModel) ToAPI() ImageMappingAPIModel {
+	return ImageMappingAPIModel{
+		Name:        self.ImageName.ValueString(),
+		CloudConfig: self.CloudConfig.ValueString(),
+	}
+}
@@ -0,0 +1,28 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+)
+
+func ImageMappingSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"image_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the image on the cloud (e.g. the template of " +
+					"vSphere or the AMI of AWS)",
+				Required: true,
+			},
+			"cloud_config": schema.StringAttribute{
+				MarkdownDescription: "Cloud config (cloud-init) applied to the machines " +
+					"provisioned with the image, default is empty",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(""),
+			},
+		},
+	}
+}
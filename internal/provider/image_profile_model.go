@@ -0,0 +1,108 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ImageProfileModel describes the resource data model.
+type ImageProfileModel struct {
+	Id               types.String                 `tfsdk:"id"`
+	Name             types.String                 `tfsdk:"name"`
+	Description      types.String                 `tfsdk:"description"`
+	RegionId         types.String                 `tfsdk:"region_id"`
+	ExternalRegionId types.String                 `tfsdk:"external_region_id"`
+	CloudAccountId   types.String                 `tfsdk:"cloud_account_id"`
+	ImageMapping     map[string]ImageMappingModel `tfsdk:"image_mapping"`
+	OrgId            types.String                 `tfsdk:"org_id"`
+}
+
+// ImageProfileAPIModel describes the resource API model.
+type ImageProfileAPIModel struct {
+	Id               string `json:"id,omitempty"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	RegionId         string `json:"regionId"`
+	ExternalRegionId string `json:"externalRegionId,omitempty"`
+	CloudAccountId   string `json:"cloudAccountId,omitempty"`
+	OrgId            string `json:"orgId,omitempty"`
+
+	// The mapping is sent as imageMapping and returned as imageMappings.mapping
+	ImageMapping  map[string]ImageMappingAPIModel `json:"imageMapping"`
+	ImageMappings *ImageMappingsAPIModel          `json:"imageMappings,omitempty"`
+
+	// The region is returned as a link
+	Links *IaaSLinksAPIModel `json:"_links,omitempty"`
+}
+
+// ImageMappingsAPIModel describes the mapping as returned by the API.
+type ImageMappingsAPIModel struct {
+	Mapping map[string]ImageMappingAPIModel `json:"mapping"`
+}
+
+func (self ImageProfileModel) String() string {
+	return fmt.Sprintf(
+		"Image Profile %s (%s)",
+		self.Id.ValueString(),
+		self.Name.ValueString())
+}
+
+// Return an appropriate key that can be used for naming mutexes.
+// Create: Identifier can be used to prevent concurrent creation of image profiles.
+// Read Update Delete: Identifier can be used to prevent concurrent modifications on the instance.
+func (self ImageProfileModel) LockKey() string {
+	return "image-profile-" + self.Id.ValueString()
+}
+
+func (self ImageProfileModel) CreatePath() string {
+	return "iaas/api/image-profiles"
+}
+
+func (self ImageProfileModel) ReadPath() string {
+	return "iaas/api/image-profiles/" + self.Id.ValueString()
+}
+
+func (self ImageProfileModel) UpdatePath() string {
+	return self.ReadPath()
+}
+
+func (self ImageProfileModel) DeletePath() string {
+	return self.ReadPath()
+}
+
+func (self *ImageProfileModel) FromAPI(raw ImageProfileAPIModel) {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Description = types.StringValue(raw.Description)
+	self.RegionId = types.StringValue(RegionIdFromAPI(raw.RegionId, raw.Links))
+	self.ExternalRegionId = types.StringValue(raw.ExternalRegionId)
+	self.CloudAccountId = types.StringValue(raw.CloudAccountId)
+	self.OrgId = types.StringValue(raw.OrgId)
+
+	self.ImageMapping = map[string]ImageMappingModel{}
+	if raw.ImageMappings != nil {
+		for key, mappingRaw := range raw.ImageMappings.Mapping {
+			mapping := ImageMappingModel{}
+			mapping.FromAPI(mappingRaw)
+			self.ImageMapping[key] = mapping
+		}
+	}
+}
+
+func (self ImageProfileModel) ToAPI() ImageProfileAPIModel {
+	mappingRaw := map[string]ImageMappingAPIModel{}
+	for key, mapping := range self.ImageMapping {
+		mappingRaw[key] = mapping.ToAPI()
+	}
+	return ImageProfileAPIModel{
+		Id:           self.Id.ValueString(),
+		Name:         self.Name.ValueString(),
+		Description:  self.Description.ValueString(),
+		RegionId:     self.RegionId.ValueString(),
+		ImageMapping: mappingRaw,
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ImageProfileResource{}
+var _ resource.ResourceWithImportState = &ImageProfileResource{}
+
+func NewImageProfileResource() resource.Resource {
+	return &ImageProfileResource{}
+}
+
+// ImageProfileResource defines the resource implementation.
+type ImageProfileResource struct {
+	client *AriaClient
+}
+
+func (self *ImageProfileResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_image_profile"
+}
+
+func (self *ImageProfileResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = ImageProfileSchema()
+}
+
+func (self *ImageProfileResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	self.client = GetResourceClient(ctx, req, resp)
+}
+
+func (self *ImageProfileResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	// Read Terraform plan data into the model
+	var profile ImageProfileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var profileFromAPI ImageProfileAPIModel
+	path := profile.CreatePath()
+	response, err := self.client.R(ctx, path).
+		SetBody(profile.ToAPI()).
+		SetResult(&profileFromAPI).
+		Post(path)
+	err = self.client.HandleAPIResponse(response, err, []int{201})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to create %s, got error: %s", profile.String(), err))
+		return
+	}
+
+	// Save cloud profile into Terraform state
+	profile.FromAPI(profileFromAPI)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
+	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", profile.String()))
+}
+
+func (self *ImageProfileResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	// Read Terraform prior state data into the model
+	var profile ImageProfileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var profileFromAPI ImageProfileAPIModel
+	found, _, readDiags := self.client.ReadIt(ctx, &profile, &profileFromAPI)
+	resp.Diagnostics.Append(readDiags...)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated cloud profile into Terraform state
+	profile.FromAPI(profileFromAPI)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
+}
+
+func (self *ImageProfileResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	// Read Terraform plan data into the model
+	var profile ImageProfileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var profileFromAPI ImageProfileAPIModel
+	path := profile.UpdatePath()
+	response, err := self.client.R(ctx, path).
+		SetBody(profile.ToAPI()).
+		SetResult(&profileFromAPI).
+		Patch(path)
+	err = self.client.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to update %s, got error: %s", profile.String(), err))
+		return
+	}
+
+	// Save updated cloud profile into Terraform state
+	profile.FromAPI(profileFromAPI)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
+	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", profile.String()))
+}
+
+func (self *ImageProfileResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// Read Terraform prior state data into the model
+	var profile ImageProfileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &profile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(self.client.DeleteIt(ctx, &profile)...)
+}
+
+func (self *ImageProfileResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
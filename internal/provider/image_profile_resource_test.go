@@ -0,0 +1,91 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccImageProfileResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region of the image profile."
+  type        = string
+}
+
+resource "aria_image_profile" "test" {
+  name      = "ARIA_PROVIDER_TEST_IMAGE_PROFILE"
+  region_id = var.test_region_id
+
+  image_mapping = {
+    ubuntu = {
+      image_name = "ubuntu-24.04-template"
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aria_image_profile.test", "id"),
+					resource.TestCheckResourceAttr("aria_image_profile.test", "name", "ARIA_PROVIDER_TEST_IMAGE_PROFILE"),
+					resource.TestCheckResourceAttr("aria_image_profile.test", "description", ""),
+					resource.TestCheckResourceAttrSet("aria_image_profile.test", "external_region_id"),
+					resource.TestCheckResourceAttrSet("aria_image_profile.test", "cloud_account_id"),
+					resource.TestCheckResourceAttr("aria_image_profile.test", "image_mapping.%", "1"),
+					resource.TestCheckResourceAttr("aria_image_profile.test", "image_mapping.ubuntu.image_name", "ubuntu-24.04-template"),
+					resource.TestCheckResourceAttr("aria_image_profile.test", "image_mapping.ubuntu.cloud_config", ""),
+					resource.TestCheckResourceAttrSet("aria_image_profile.test", "org_id"),
+				),
+			},
+			// Update testing
+			{
+				Config: `
+variable "test_region_id" {
+  description = "Region of the image profile."
+  type        = string
+}
+
+resource "aria_image_profile" "test" {
+  name        = "ARIA_PROVIDER_TEST_IMAGE_PROFILE"
+  description = "Image profile managed by the acceptance tests."
+  region_id   = var.test_region_id
+
+  image_mapping = {
+    ubuntu = {
+      image_name   = "ubuntu-24.04-template"
+      cloud_config = <<-EOT
+        #cloud-config
+        package_update: true
+      EOT
+    }
+    debian = {
+      image_name = "debian-12-template"
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_image_profile.test", "description", "Image profile managed by the acceptance tests."),
+					resource.TestCheckResourceAttr("aria_image_profile.test", "image_mapping.%", "2"),
+					resource.TestCheckResourceAttr("aria_image_profile.test", "image_mapping.ubuntu.cloud_config", "#cloud-config\npackage_update: true\n"),
+					resource.TestCheckResourceAttr("aria_image_profile.test", "image_mapping.debian.image_name", "debian-12-template"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aria_image_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
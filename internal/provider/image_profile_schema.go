@@ -0,0 +1,39 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+)
+
+func ImageProfileSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Image profile resource, maps the images referenced by the cloud " +
+			"templates to the images (e.g. templates) of a region",
+		Attributes: map[string]schema.Attribute{
+			"id": ComputedIdentifierSchema(""),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "A friendly name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Describe the resource in few sentences",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"region_id":          ProfileRegionIdSchema(),
+			"external_region_id": ProfileExternalRegionIdSchema(),
+			"cloud_account_id":   ProfileCloudAccountIdSchema(),
+			"image_mapping": schema.MapNestedAttribute{
+				MarkdownDescription: "Images of the region, the key is the name of the image " +
+					"referenced by the cloud templates (e.g. `ubuntu`)",
+				Required:     true,
+				NestedObject: ImageMappingSchema(),
+			},
+			"org_id": ComputedOrganizationIdSchema(),
+		},
+	}
+}
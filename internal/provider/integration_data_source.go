@@ -60,7 +60,7 @@ func (self *IntegrationDataSource) Read(
 
 	var responseFromAPI IntegrationResponseAPIodel
 	path := integration.ReadPath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetQueryParam("size", "1").
 		SetQueryParam("page", "0").
 		SetQueryParam("sort", "name,asc").
@@ -0,0 +1,63 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// KeyValueTagModel describes the resource data model.
+type KeyValueTagModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+// KeyValueTagAPIModel describes the resource API model.
+type KeyValueTagAPIModel struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func (self *KeyValueTagModel) FromAPI(raw KeyValueTagAPIModel) {
+	self.Key = types.StringValue(raw.Key)
+	self.Value = types.StringValue(raw.Value)
+}
+
+func (self KeyValueTagModel) ToAPI() KeyValueTagAPIModel {
+	return KeyValueTagAPIModel{
+		Key:   self.Key.ValueString(),
+		Value: self.Value.ValueString(),
+	}
+}
+
+// Utils -------------------------------------------------------------------------------------------
+
+// Used to convert structure to a types.Object.
+func (self KeyValueTagModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"key":   types.StringType,
+		"value": types.StringType,
+	}
+}
+
+// Convert the tags returned by the API.
+func KeyValueTagsFromAPI(raw []KeyValueTagAPIModel) []KeyValueTagModel {
+	tags := []KeyValueTagModel{}
+	for _, tagRaw := range raw {
+		tag := KeyValueTagModel{}
+		tag.FromAPI(tagRaw)
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// Convert the tags to send them to the API.
+func KeyValueTagsToAPI(tags []KeyValueTagModel) []KeyValueTagAPIModel {
+	tagsRaw := []KeyValueTagAPIModel{}
+	for _, tag := range tags {
+		tagsRaw = append(tagsRaw, tag.ToAPI())
+	}
+	return tagsRaw
+}
@@ -0,0 +1,58 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Tags (key and value) attached to the IaaS resources (e.g. zones), default is none.
+func KeyValueTagsSchema(description string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		Optional:            true,
+		Default: setdefault.StaticValue(types.SetValueMust(
+			types.ObjectType{AttrTypes: KeyValueTagModel{}.AttributeTypes()},
+			[]attr.Value{})),
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"key": schema.StringAttribute{
+					MarkdownDescription: "Key",
+					Required:            true,
+				},
+				"value": schema.StringAttribute{
+					MarkdownDescription: "Value",
+					Computed:            true,
+					Optional:            true,
+					Default:             stringdefault.StaticString(""),
+				},
+			},
+		},
+	}
+}
+
+func ComputedKeyValueTagsSchema(description string) dataschema.SetNestedAttribute {
+	return dataschema.SetNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		NestedObject: dataschema.NestedAttributeObject{
+			Attributes: map[string]dataschema.Attribute{
+				"key": dataschema.StringAttribute{
+					MarkdownDescription: "Key",
+					Computed:            true,
+				},
+				"value": dataschema.StringAttribute{
+					MarkdownDescription: "Value",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrchestratorActionDataSource{}
+
+func NewOrchestratorActionDataSource() datasource.DataSource {
+	return &OrchestratorActionDataSource{}
+}
+
+// OrchestratorActionDataSource defines the data source implementation.
+type OrchestratorActionDataSource struct {
+	client *AriaClient
+}
+
+func (self *OrchestratorActionDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_orchestrator_action"
+}
+
+func (self *OrchestratorActionDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = OrchestratorActionDataSourceSchema()
+}
+
+func (self *OrchestratorActionDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self OrchestratorActionDataSource) ConfigValidators(
+	ctx context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("fqn"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("module"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (self *OrchestratorActionDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var action OrchestratorActionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &action)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readPath, err := action.ReadPath()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Configuration error",
+			fmt.Sprintf("Unable to find %s, got error: %s", action.String(), err))
+		return
+	}
+
+	// Fully qualified names are unique, no need to list the actions
+	var actionFromAPI OrchestratorActionAPIModel
+	found, _, readDiags := self.client.ReadIt(ctx, OrchestratorActionModel{
+		Id:     action.Id,
+		Name:   action.Name,
+		Module: action.Module,
+		FQN:    action.FQN,
+	}, &actionFromAPI, readPath)
+	resp.Diagnostics.Append(readDiags...)
+	if !found {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to find %s, got error: not found", action.String()))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save action into Terraform state
+	action.FromAPI(actionFromAPI)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &action)...)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrchestratorActionDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+resource "aria_orchestrator_category" "test" {
+  name      = "aria_provider_tests_action_data_source"
+  type      = "ScriptModuleCategory"
+  parent_id = ""
+}
+
+resource "aria_orchestrator_action" "test" {
+  name                 = "getAnswer"
+  module               = aria_orchestrator_category.test.path
+  fqn                  = "${aria_orchestrator_category.test.path}/getAnswer"
+  description          = "Temporary action generated by Aria provider's acceptance tests."
+  version              = "1.0.0"
+  runtime              = ""
+  runtime_memory_limit = 0
+  runtime_timeout      = 0
+  script               = "return 42;"
+  input_parameters     = []
+  output_type          = "number"
+}
+
+data "aria_orchestrator_action" "by_module_and_name" {
+  module = aria_orchestrator_category.test.path
+  name   = "getAnswer"
+
+  depends_on = [aria_orchestrator_action.test]
+}
+
+data "aria_orchestrator_action" "by_fqn" {
+  fqn = aria_orchestrator_action.test.fqn
+}
+
+data "aria_orchestrator_action" "by_id" {
+  id = aria_orchestrator_action.test.id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.aria_orchestrator_action.by_module_and_name", "id",
+						"aria_orchestrator_action.test", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"data.aria_orchestrator_action.by_module_and_name", "script", "return 42;",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.aria_orchestrator_action.by_fqn", "id",
+						"aria_orchestrator_action.test", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"data.aria_orchestrator_action.by_id", "fqn",
+						"aria_provider_tests_action_data_source/getAnswer",
+					),
+					resource.TestCheckResourceAttr(
+						"data.aria_orchestrator_action.by_id", "output_type", "number",
+					),
+				),
+			},
+			// Lookup failure testing
+			{
+				Config: `
+data "aria_orchestrator_action" "missing" {
+  fqn = "aria_provider_tests_action_data_source/noActionIsNamedLikeThis"
+}`,
+				ExpectError: regexp.MustCompile(`got\s+error:\s+not\s+found`),
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -37,6 +38,19 @@ type OrchestratorActionModel struct {
 	ValidationMessage types.String `tfsdk:"validation_message"`
 }
 
+// OrchestratorActionDataSourceModel describes the data source data model.
+type OrchestratorActionDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Module      types.String `tfsdk:"module"`
+	FQN         types.String `tfsdk:"fqn"`
+	Description types.String `tfsdk:"description"`
+	Version     types.String `tfsdk:"version"`
+	Runtime     types.String `tfsdk:"runtime"`
+	Script      types.String `tfsdk:"script"`
+	OutputType  types.String `tfsdk:"output_type"`
+}
+
 // OrchestratorActionAPIModel describes the resource API model.
 type OrchestratorActionAPIModel struct {
 	Id          string `json:"id,omitempty"`
@@ -60,6 +74,19 @@ type OrchestratorActionAPIModel struct {
 	ValidationMessage string `json:"validationMessage,omitempty"`
 }
 
+// Return the fully qualified name of an action (e.g. ch.ocsin.core/getVRAHost).
+func OrchestratorActionFQN(module string, name string) (string, error) {
+	for _, part := range [][2]string{{"module", module}, {"name", name}} {
+		if len(part[1]) == 0 {
+			return "", fmt.Errorf("%s must not be empty", part[0])
+		}
+		if strings.Contains(part[1], "/") {
+			return "", fmt.Errorf("%s %s must not contain a slash", part[0], part[1])
+		}
+	}
+	return module + "/" + name, nil
+}
+
 func (self OrchestratorActionModel) String() string {
 	return fmt.Sprintf(
 		"Orchestrator Action %s (%s)",
@@ -93,6 +120,45 @@ func (self OrchestratorActionModel) DeletePath() string {
 	return self.ReadPath()
 }
 
+func (self OrchestratorActionDataSourceModel) String() string {
+	fqn := self.FQN.ValueString()
+	if len(fqn) == 0 && len(self.Name.ValueString()) > 0 {
+		fqn = self.Module.ValueString() + "/" + self.Name.ValueString()
+	}
+	return fmt.Sprintf("Orchestrator Action (id %q, fqn %q)", self.Id.ValueString(), fqn)
+}
+
+// Return the path to read the action by identifier or by fully qualified name.
+func (self OrchestratorActionDataSourceModel) ReadPath() (string, error) {
+	if len(self.Id.ValueString()) > 0 {
+		return OrchestratorActionModel{Id: self.Id}.ReadPath(), nil
+	}
+	module, name := self.Module.ValueString(), self.Name.ValueString()
+	if fqn := self.FQN.ValueString(); len(fqn) > 0 {
+		var found bool
+		if module, name, found = strings.Cut(fqn, "/"); !found {
+			return "", fmt.Errorf("fqn %s must be module/name", fqn)
+		}
+	}
+	fqn, err := OrchestratorActionFQN(module, name)
+	if err != nil {
+		return "", err
+	}
+	return "vco/api/actions/" + fqn, nil
+}
+
+func (self *OrchestratorActionDataSourceModel) FromAPI(raw OrchestratorActionAPIModel) {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Module = types.StringValue(raw.Module)
+	self.FQN = types.StringValue(raw.FQN)
+	self.Description = types.StringValue(raw.Description)
+	self.Version = types.StringValue(raw.Version)
+	self.Runtime = types.StringValue(raw.Runtime)
+	self.Script = types.StringValue(raw.Script)
+	self.OutputType = types.StringValue(raw.OutputType)
+}
+
 func (self *OrchestratorActionModel) FromAPI(
 	ctx context.Context,
 	raw OrchestratorActionAPIModel,
@@ -71,7 +71,7 @@ func (self *OrchestratorActionResource) Create(
 	path := action.CreatePath()
 
 	self.client.Mutex.Lock(ctx, action.LockKey())
-	response, err := self.client.R(path).SetBody(actionToAPI).SetResult(&actionFromAPI).Post(path)
+	response, err := self.client.R(ctx, path).SetBody(actionToAPI).SetResult(&actionFromAPI).Post(path)
 	self.client.Mutex.Unlock(ctx, action.LockKey())
 
 	err = self.client.HandleAPIResponse(response, err, []int{201})
@@ -102,7 +102,7 @@ func (self *OrchestratorActionResource) Read(
 
 	var actionFromAPI OrchestratorActionAPIModel
 	self.client.Mutex.RLock(ctx, action.LockKey())
-	found, _, readDiags := self.client.ReadIt(&action, &actionFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &action, &actionFromAPI)
 	self.client.Mutex.RUnlock(ctx, action.LockKey())
 
 	resp.Diagnostics.Append(readDiags...)
@@ -141,7 +141,7 @@ func (self *OrchestratorActionResource) Update(
 	self.client.Mutex.Lock(ctx, action.LockKey())
 
 	path := action.UpdatePath()
-	response, err := self.client.R(path).SetBody(actionToAPI).Put(path)
+	response, err := self.client.R(ctx, path).SetBody(actionToAPI).Put(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		self.client.Mutex.Unlock(ctx, action.LockKey())
@@ -154,7 +154,7 @@ func (self *OrchestratorActionResource) Update(
 	// Read (using API) to retrieve the action content (and not empty stuff)
 	var actionFromAPI OrchestratorActionAPIModel
 	path = action.ReadPath()
-	response, err = self.client.R(path).SetResult(&actionFromAPI).Get(path)
+	response, err = self.client.R(ctx, path).SetResult(&actionFromAPI).Get(path)
 
 	self.client.Mutex.Unlock(ctx, action.LockKey())
 
@@ -183,7 +183,7 @@ func (self *OrchestratorActionResource) Delete(
 	if !resp.Diagnostics.HasError() {
 		// Do not serialize deletion (with a mutex) to allow convering (if possible) when deletion
 		// is not forced by some of the actions
-		resp.Diagnostics.Append(self.client.DeleteIt(&action)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &action)...)
 	}
 }
 
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dataschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -88,3 +89,47 @@ func OrchestratorActionSchema() schema.Schema {
 		},
 	}
 }
+
+func OrchestratorActionDataSourceSchema() dataschema.Schema {
+	return dataschema.Schema{
+		MarkdownDescription: "Orchestrator action data source, lookup by identifier, " +
+			"fully qualified name or module and name",
+		Attributes: map[string]dataschema.Attribute{
+			"id": OptionalIdentifierSchema(""),
+			"name": dataschema.StringAttribute{
+				MarkdownDescription: "Action name (e.g. getVRAHost, requires the module)",
+				Computed:            true,
+				Optional:            true,
+			},
+			"module": dataschema.StringAttribute{
+				MarkdownDescription: "Action module (e.g. ch.ocsin.core, requires the name)",
+				Computed:            true,
+				Optional:            true,
+			},
+			"fqn": dataschema.StringAttribute{
+				MarkdownDescription: "Action fully qualified name " +
+					"(aka FQN, e.g. ch.ocsin.core/getVRAHost)",
+				Computed: true,
+				Optional: true,
+			},
+			"description": ComputedDescriptionSchema(),
+			"version": dataschema.StringAttribute{
+				MarkdownDescription: "Action version",
+				Computed:            true,
+			},
+			"runtime": dataschema.StringAttribute{
+				MarkdownDescription: "Runtime (empty string for javascript " +
+					"or when using a custom execution environment)",
+				Computed: true,
+			},
+			"script": dataschema.StringAttribute{
+				MarkdownDescription: "Action source code",
+				Computed:            true,
+			},
+			"output_type": dataschema.StringAttribute{
+				MarkdownDescription: "Action return type",
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrchestratorCategoryDataSource{}
+
+func NewOrchestratorCategoryDataSource() datasource.DataSource {
+	return &OrchestratorCategoryDataSource{}
+}
+
+// OrchestratorCategoryDataSource defines the data source implementation.
+type OrchestratorCategoryDataSource struct {
+	client *AriaClient
+}
+
+func (self *OrchestratorCategoryDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_orchestrator_category"
+}
+
+func (self *OrchestratorCategoryDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = OrchestratorCategoryDataSourceSchema()
+}
+
+func (self *OrchestratorCategoryDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self *OrchestratorCategoryDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var category OrchestratorCategoryModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &category)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := category.ListQuery()
+	categoriesRaw, err := ListAll[OrchestratorCategoryAPIModel](ctx, self.client, query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to list %s, got error: %s", query.String(), err))
+		return
+	}
+
+	// Lookup the category by path (the listed categories may not expose their path)
+	path := category.Path.ValueString()
+	matching := []OrchestratorCategoryAPIModel{}
+	for _, categoryRaw := range categoriesRaw {
+		if !category.MatchName(categoryRaw) {
+			continue
+		}
+		candidate := OrchestratorCategoryModel{Id: types.StringValue(categoryRaw.Id)}
+		var categoryFromAPI OrchestratorCategoryAPIModel
+		found, _, readDiags := self.client.ReadIt(ctx, candidate, &categoryFromAPI)
+		resp.Diagnostics.Append(readDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if found && categoryFromAPI.Path == path {
+			matching = append(matching, categoryFromAPI)
+		}
+	}
+
+	if len(matching) != 1 {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf(
+				"Unable to find Orchestrator category %s, %d categories are matching, "+
+					"expected exactly one",
+				path, len(matching)))
+		return
+	}
+
+	// Save category into Terraform state
+	category.FromAPI(matching[0])
+	resp.Diagnostics.Append(resp.State.Set(ctx, &category)...)
+}
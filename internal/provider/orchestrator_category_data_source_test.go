@@ -0,0 +1,73 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrchestratorCategoryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+resource "aria_orchestrator_category" "root" {
+  name      = "TEST_ARIA_PROVIDER_CATEGORY"
+  type      = "WorkflowCategory"
+  parent_id = ""
+}
+
+resource "aria_orchestrator_category" "child" {
+  name      = "Child"
+  type      = "WorkflowCategory"
+  parent_id = aria_orchestrator_category.root.id
+}
+
+data "aria_orchestrator_category" "child" {
+  path = aria_orchestrator_category.child.path
+  type = "WorkflowCategory"
+}
+
+data "aria_orchestrator_category" "root" {
+  path = aria_orchestrator_category.root.path
+
+  depends_on = [aria_orchestrator_category.child]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.aria_orchestrator_category.child", "id",
+						"aria_orchestrator_category.child", "id",
+					),
+					resource.TestCheckResourceAttr("data.aria_orchestrator_category.child", "name", "Child"),
+					resource.TestCheckResourceAttr("data.aria_orchestrator_category.child", "path", "TEST_ARIA_PROVIDER_CATEGORY/Child"),
+					resource.TestCheckResourceAttrPair(
+						"data.aria_orchestrator_category.child", "parent_id",
+						"aria_orchestrator_category.root", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.aria_orchestrator_category.root", "id",
+						"aria_orchestrator_category.root", "id",
+					),
+					resource.TestCheckResourceAttr("data.aria_orchestrator_category.root", "type", "WorkflowCategory"),
+					resource.TestCheckResourceAttr("data.aria_orchestrator_category.root", "parent_id", ""),
+				),
+			},
+			// Lookup failure testing
+			{
+				Config: `
+data "aria_orchestrator_category" "missing" {
+  path = "TEST_ARIA_PROVIDER_CATEGORY/NoCategoryIsNamedLikeThis"
+  type = "WorkflowCategory"
+}`,
+				ExpectError: regexp.MustCompile(`0\s+categories\s+are\s+matching`),
+			},
+		},
+	})
+}
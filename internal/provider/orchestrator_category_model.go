@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -43,6 +44,21 @@ func (self OrchestratorCategoryModel) LockKey() string {
 	return "orchestrator-category-" + self.Id.ValueString()
 }
 
+// Return the query retrieving the categories (of the type, if set).
+func (self OrchestratorCategoryModel) ListQuery() ListQuery {
+	query := ListQuery{Path: "vco/api/categories", Pagination: LIST_PAGINATION_VRO}
+	if len(self.Type.ValueString()) > 0 {
+		query.Params = map[string]string{"categoryType": self.Type.ValueString()}
+	}
+	return query
+}
+
+// Return true if the category may be at the path (its name is the last element of the path).
+func (self OrchestratorCategoryModel) MatchName(raw OrchestratorCategoryAPIModel) bool {
+	path := self.Path.ValueString()
+	return raw.Name == path[strings.LastIndex(path, "/")+1:]
+}
+
 func (self OrchestratorCategoryModel) CreatePath() string {
 	if len(self.ParentId.ValueString()) == 0 {
 		return "vco/api/categories"
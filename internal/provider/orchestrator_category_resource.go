@@ -63,7 +63,7 @@ func (self *OrchestratorCategoryResource) Create(
 
 	var categoryFromAPI OrchestratorCategoryAPIModel
 	path := category.CreatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetBody(category.ToAPI()).
 		SetResult(&categoryFromAPI).
 		Post(path)
@@ -94,7 +94,7 @@ func (self *OrchestratorCategoryResource) Read(
 	}
 
 	var categoryFromAPI OrchestratorCategoryAPIModel
-	found, _, readDiags := self.client.ReadIt(&category, &categoryFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &category, &categoryFromAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -123,7 +123,7 @@ func (self *OrchestratorCategoryResource) Update(
 	}
 
 	path := category.UpdatePath()
-	response, err := self.client.R(path).SetBody(category.ToAPI()).Put(path)
+	response, err := self.client.R(ctx, path).SetBody(category.ToAPI()).Put(path)
 	err = self.client.HandleAPIResponse(response, err, []int{204})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -135,7 +135,7 @@ func (self *OrchestratorCategoryResource) Update(
 	// Read (using API) to retrieve the category content (and not empty stuff)
 	var categoryFromAPI OrchestratorCategoryAPIModel
 	path = category.ReadPath()
-	response, err = self.client.R(path).SetResult(&categoryFromAPI).Get(category.ReadPath())
+	response, err = self.client.R(ctx, path).SetResult(&categoryFromAPI).Get(category.ReadPath())
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -159,7 +159,7 @@ func (self *OrchestratorCategoryResource) Delete(
 	var category OrchestratorCategoryModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &category)...)
 	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&category)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &category)...)
 	}
 }
 
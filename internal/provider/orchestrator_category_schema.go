@@ -5,6 +5,7 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dataschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
@@ -47,3 +48,44 @@ func OrchestratorCategorySchema() schema.Schema {
 		},
 	}
 }
+
+func OrchestratorCategoryDataSourceSchema() dataschema.Schema {
+	return dataschema.Schema{
+		MarkdownDescription: "Orchestrator category data source, lookup by path",
+		Attributes: map[string]dataschema.Attribute{
+			"id": ComputedIdentifierSchema(""),
+			"name": dataschema.StringAttribute{
+				MarkdownDescription: "Category's name",
+				Computed:            true,
+			},
+			"path": dataschema.StringAttribute{
+				MarkdownDescription: "Category's path (e.g. Company/Network, must match exactly " +
+					"one category)",
+				Required: true,
+			},
+			"type": dataschema.StringAttribute{
+				MarkdownDescription: "Category's type (restrict the lookup to this type), " +
+					"`ConfigurationElementCategory`, " +
+					"`PolicyTemplateCategory`, " +
+					"`ResourceElementCategory`, " +
+					"`ScriptModuleCategory` or " +
+					"`WorkflowCategory`",
+				Computed: true,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{
+						"ConfigurationElementCategory",
+						"PolicyTemplateCategory",
+						"ResourceElementCategory",
+						"ScriptModuleCategory",
+						"WorkflowCategory",
+					}...),
+				},
+			},
+			"parent_id": dataschema.StringAttribute{
+				MarkdownDescription: "Category's parent (empty string for a root category).",
+				Computed:            true,
+			},
+		},
+	}
+}
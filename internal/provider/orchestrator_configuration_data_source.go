@@ -60,7 +60,7 @@ func (self *OrchestratorConfigurationDataSource) Read(
 
 	var configurationRaw OrchestratorConfigurationAPIModel
 	path := configuration.ReadPath()
-	response, err := self.client.R(path).SetResult(&configurationRaw).Get(path)
+	response, err := self.client.R(ctx, path).SetResult(&configurationRaw).Get(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
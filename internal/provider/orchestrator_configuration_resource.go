@@ -69,7 +69,7 @@ func (self *OrchestratorConfigurationResource) Create(
 
 	var configurationFromAPI OrchestratorConfigurationAPIModel
 	path := configuration.CreatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetBody(configurationToAPI).
 		SetResult(&configurationFromAPI).
 		Post(path)
@@ -100,7 +100,7 @@ func (self *OrchestratorConfigurationResource) Read(
 	}
 
 	var configurationFromAPI OrchestratorConfigurationAPIModel
-	found, response, someDiags := self.client.ReadIt(&configuration, &configurationFromAPI)
+	found, response, someDiags := self.client.ReadIt(ctx, &configuration, &configurationFromAPI)
 	resp.Diagnostics.Append(someDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -143,7 +143,7 @@ func (self *OrchestratorConfigurationResource) Update(
 
 	// No response body from API, only the changeset (version) available in response headers
 	path := configuration.UpdatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetHeader("x-vro-changeset-sha", configurationFromState.VersionId.ValueString()).
 		SetBody(configurationToAPI).
 		Put(path)
@@ -170,7 +170,7 @@ func (self *OrchestratorConfigurationResource) Delete(
 	var configuration OrchestratorConfigurationModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &configuration)...)
 	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&configuration)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &configuration)...)
 	}
 }
 
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -36,6 +37,8 @@ type OrchestratorEnvironmentModel struct {
 	ValidationMessage              types.String `tfsdk:"validation_message"`
 
 	WaitUpToDate types.Bool `tfsdk:"wait_up_to_date"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // OrchestratorEnvironmentAPIModel describes the resource API model.
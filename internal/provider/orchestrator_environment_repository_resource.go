@@ -14,6 +14,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &OrchestratorEnvironmentRepositoryResource{}
+var _ ResourceWithSensitivePaths = &OrchestratorEnvironmentRepositoryResource{}
 var _ resource.ResourceWithImportState = &OrchestratorEnvironmentRepositoryResource{}
 
 func NewOrchestratorEnvironmentRepositoryResource() resource.Resource {
@@ -41,6 +42,11 @@ func (self *OrchestratorEnvironmentRepositoryResource) Schema(
 	resp.Schema = OrchestratorEnvironmentRepositorySchema()
 }
 
+// Sensitive attributes are redacted from the bodies of the environment repositories API.
+func (self *OrchestratorEnvironmentRepositoryResource) SensitivePaths() []string {
+	return []string{OrchestratorEnvironmentRepositoryModel{}.CreatePath()}
+}
+
 func (self *OrchestratorEnvironmentRepositoryResource) Configure(
 	ctx context.Context,
 	req resource.ConfigureRequest,
@@ -63,7 +69,7 @@ func (self *OrchestratorEnvironmentRepositoryResource) Create(
 
 	var repositoryFromAPI OrchestratorEnvironmentRepositoryAPIModel
 	path := repository.CreatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetBody(repository.ToAPI()).
 		SetResult(&repositoryFromAPI).
 		Post(path)
@@ -94,7 +100,7 @@ func (self *OrchestratorEnvironmentRepositoryResource) Read(
 	}
 
 	var repositoryFromAPI OrchestratorEnvironmentRepositoryAPIModel
-	found, _, readDiags := self.client.ReadIt(&repository, &repositoryFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &repository, &repositoryFromAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -125,7 +131,7 @@ func (self *OrchestratorEnvironmentRepositoryResource) Update(
 	var repositoryFromAPI OrchestratorEnvironmentRepositoryAPIModel
 	path := repository.UpdatePath()
 	body := repository.ToAPI()
-	response, err := self.client.R(path).SetBody(body).SetResult(&repositoryFromAPI).Put(path)
+	response, err := self.client.R(ctx, path).SetBody(body).SetResult(&repositoryFromAPI).Put(path)
 	err = self.client.HandleAPIResponse(response, err, []int{202})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -149,7 +155,7 @@ func (self *OrchestratorEnvironmentRepositoryResource) Delete(
 	var repository OrchestratorEnvironmentRepositoryModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &repository)...)
 	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&repository)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &repository)...)
 	}
 }
 
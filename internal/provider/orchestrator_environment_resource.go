@@ -40,7 +40,7 @@ func (self *OrchestratorEnvironmentResource) Schema(
 	req resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
-	resp.Schema = OrchestratorEnvironmentSchema()
+	resp.Schema = OrchestratorEnvironmentSchema(ctx)
 }
 
 func (self *OrchestratorEnvironmentResource) Configure(
@@ -63,6 +63,15 @@ func (self *OrchestratorEnvironmentResource) Create(
 		return
 	}
 
+	createTimeout, timeoutDiags := environment.Timeouts.Create(ctx, DEFAULT_IMPORT_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	environmentToAPI, diags := environment.ToAPI(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -71,7 +80,7 @@ func (self *OrchestratorEnvironmentResource) Create(
 
 	var environmentFromAPI OrchestratorEnvironmentAPIModel
 	path := environment.CreatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetBody(environmentToAPI).
 		SetResult(&environmentFromAPI).
 		Post(path)
@@ -107,7 +116,7 @@ func (self *OrchestratorEnvironmentResource) Read(
 	}
 
 	var environmentFromAPI OrchestratorEnvironmentAPIModel
-	found, response, someDiags := self.client.ReadIt(&environment, &environmentFromAPI)
+	found, response, someDiags := self.client.ReadIt(ctx, &environment, &environmentFromAPI)
 	resp.Diagnostics.Append(someDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -135,6 +144,15 @@ func (self *OrchestratorEnvironmentResource) Update(
 		return
 	}
 
+	updateTimeout, timeoutDiags := environment.Timeouts.Update(ctx, DEFAULT_IMPORT_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Read Terraform state data into the model
 	var environmentFromState OrchestratorEnvironmentModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &environmentFromState)...)
@@ -150,7 +168,7 @@ func (self *OrchestratorEnvironmentResource) Update(
 
 	var environmentFromAPI OrchestratorEnvironmentAPIModel
 	path := environment.UpdatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetHeader("x-vro-changeset-sha", environmentFromState.VersionId.ValueString()).
 		SetBody(environmentToAPI).
 		SetResult(&environmentFromAPI).
@@ -183,9 +201,20 @@ func (self *OrchestratorEnvironmentResource) Delete(
 	// Read Terraform prior state data into the model
 	var environment OrchestratorEnvironmentModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &environment)...)
-	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&environment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, timeoutDiags := environment.Timeouts.Delete(ctx, DEFAULT_DELETE_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(self.client.DeleteIt(ctx, &environment)...)
 }
 
 func (self *OrchestratorEnvironmentResource) ImportState(
@@ -212,17 +241,21 @@ func (self *OrchestratorEnvironmentResource) WaitUpToDate(
 	name := environment.String()
 	tflog.Debug(ctx, fmt.Sprintf("Wait %s to be up-to-date...", name))
 
-	// Poll for environment to be up-to-date to 10 minutes (60 x 10 seconds)
-	maxAttempts := 60
-	for attempt := 0; attempt < maxAttempts; attempt++ {
+	// Poll for environment to be up-to-date until the operation times out (see timeouts)
+	interval := self.client.GetPollInterval(ctx, time.Duration(10)*time.Second)
+	for attempt := 0; ; attempt++ {
 		// Poll resource until up-to-date
-		time.Sleep(time.Duration(10) * time.Second)
+		if err := Sleep(ctx, interval); err != nil {
+			diags.Append(InterruptedDiagnostics(
+				err, fmt.Sprintf("waiting for %s to be up-to-date", name))...)
+			return diags
+		}
 		tflog.Debug(
 			ctx,
-			fmt.Sprintf("Poll %d of %d - Check %s is up-to-date...", attempt+1, maxAttempts, name))
+			fmt.Sprintf("Poll %d - Check %s is up-to-date...", attempt+1, name))
 
 		var environmentFromAPI OrchestratorEnvironmentAPIModel
-		found, response, someDiags := self.client.ReadIt(environment, &environmentFromAPI)
+		found, response, someDiags := self.client.ReadIt(ctx, environment, &environmentFromAPI)
 		diags.Append(someDiags...)
 		if !found {
 			diags.AddError(
@@ -237,9 +270,4 @@ func (self *OrchestratorEnvironmentResource) WaitUpToDate(
 			return diags
 		}
 	}
-
-	diags.AddError(
-		"Client error",
-		fmt.Sprintf("Timeout while waiting for %s to be up-to-date.", name))
-	return diags
 }
@@ -4,12 +4,14 @@
 package provider
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func OrchestratorEnvironmentSchema() schema.Schema {
+func OrchestratorEnvironmentSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Orchestrator Environment resource",
 		Attributes: map[string]schema.Attribute{
@@ -73,11 +75,15 @@ func OrchestratorEnvironmentSchema() schema.Schema {
 				Computed:            true,
 			},
 			"wait_up_to_date": schema.BoolAttribute{
-				MarkdownDescription: "Wait for the environment to be up-to-date (up to 10 minutes)",
-				Computed:            true,
-				Optional:            true,
-				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Wait for the environment to be up-to-date " +
+					"(up to the create or update timeout, 20 minutes by default)",
+				Computed: true,
+				Optional: true,
+				Default:  booldefault.StaticBool(true),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": TimeoutsSchema(ctx),
+		},
 	}
 }
@@ -69,7 +69,7 @@ func (self *OrchestratorTaskResource) Create(
 
 	var taskFromAPI OrchestratorTaskAPIModel
 	path := task.CreatePath()
-	response, err := self.client.R(path).SetBody(taskToAPI).SetResult(&taskFromAPI).Post(path)
+	response, err := self.client.R(ctx, path).SetBody(taskToAPI).SetResult(&taskFromAPI).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{202})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -97,7 +97,7 @@ func (self *OrchestratorTaskResource) Read(
 	}
 
 	var taskFromAPI OrchestratorTaskAPIModel
-	found, _, readDiags := self.client.ReadIt(&task, &taskFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &task, &taskFromAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -133,7 +133,7 @@ func (self *OrchestratorTaskResource) Update(
 
 	var taskFromAPI OrchestratorTaskAPIModel
 	path := task.UpdatePath()
-	response, err := self.client.R(path).SetBody(taskToAPI).SetResult(&taskFromAPI).Post(path)
+	response, err := self.client.R(ctx, path).SetBody(taskToAPI).SetResult(&taskFromAPI).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -157,7 +157,7 @@ func (self *OrchestratorTaskResource) Delete(
 	var task OrchestratorTaskModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &task)...)
 	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&task)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &task)...)
 	}
 }
 
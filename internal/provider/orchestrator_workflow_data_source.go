@@ -0,0 +1,100 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrchestratorWorkflowDataSource{}
+
+func NewOrchestratorWorkflowDataSource() datasource.DataSource {
+	return &OrchestratorWorkflowDataSource{}
+}
+
+// OrchestratorWorkflowDataSource defines the data source implementation.
+type OrchestratorWorkflowDataSource struct {
+	client *AriaClient
+}
+
+func (self *OrchestratorWorkflowDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_orchestrator_workflow"
+}
+
+func (self *OrchestratorWorkflowDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = OrchestratorWorkflowDataSourceSchema()
+}
+
+func (self *OrchestratorWorkflowDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self OrchestratorWorkflowDataSource) ConfigValidators(
+	ctx context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (self *OrchestratorWorkflowDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var workflow OrchestratorWorkflowDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &workflow)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Lookup the workflow (the list is the only way to retrieve its category)
+	workflowRaw, err := FindOne(ctx, self.client, workflow.ListQuery(), workflow.Match)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to find %s, got error: %s", workflow.String(), err))
+		return
+	}
+	workflow.FromAPI(workflowRaw)
+
+	var contentFromAPI OrchestratorWorkflowContentAPIModel
+	found, _, readDiags := self.client.ReadIt(
+		ctx, OrchestratorWorkflowModel{Id: workflow.Id}, &contentFromAPI)
+	resp.Diagnostics.Append(readDiags...)
+	if !found {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to find %s, got error: not found", workflow.String()))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save workflow into Terraform state
+	workflow.FromContentAPI(contentFromAPI)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &workflow)...)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrchestratorWorkflowDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+resource "aria_orchestrator_category" "root" {
+  name      = "TEST_ARIA_PROVIDER_WORKFLOW"
+  type      = "WorkflowCategory"
+  parent_id = ""
+}
+
+resource "aria_orchestrator_workflow" "test" {
+  name        = "Test Workflow Data Source"
+  description = "Workflow generated by the acceptance tests of Aria provider."
+  category_id = aria_orchestrator_category.root.id
+  version     = "0.1.0"
+
+  position = { x = 100, y = 50 }
+
+  restart_mode            = 1 # resume
+  resume_from_failed_mode = 0 # default
+
+  attrib        = jsonencode([])
+  presentation  = jsonencode({})
+  workflow_item = jsonencode([])
+
+  input_parameters  = []
+  output_parameters = []
+
+  input_forms = jsonencode([{ layout = { pages = [] }, schema = {} }])
+
+  wait_imported = false
+}
+
+data "aria_orchestrator_workflow" "by_name" {
+  name        = aria_orchestrator_workflow.test.name
+  category_id = aria_orchestrator_category.root.id
+}
+
+data "aria_orchestrator_workflow" "by_id" {
+  id = aria_orchestrator_workflow.test.id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.aria_orchestrator_workflow.by_name", "id",
+						"aria_orchestrator_workflow.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.aria_orchestrator_workflow.by_name", "version", "0.1.0"),
+					resource.TestCheckResourceAttr(
+						"data.aria_orchestrator_workflow.by_name", "description",
+						"Workflow generated by the acceptance tests of Aria provider.",
+					),
+					resource.TestCheckResourceAttr("data.aria_orchestrator_workflow.by_name", "category_name", "TEST_ARIA_PROVIDER_WORKFLOW"),
+					resource.TestCheckResourceAttr("data.aria_orchestrator_workflow.by_id", "name", "Test Workflow Data Source"),
+					resource.TestCheckResourceAttrPair(
+						"data.aria_orchestrator_workflow.by_id", "category_id",
+						"aria_orchestrator_category.root", "id",
+					),
+				),
+			},
+			// Lookup failure testing
+			{
+				Config: `
+data "aria_orchestrator_workflow" "missing" {
+  name = "NO-WORKFLOW-IS-NAMED-LIKE-THIS"
+}`,
+				ExpectError: regexp.MustCompile(`no\s+instance\s+of\s+vco/api/workflows`),
+			},
+		},
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -46,6 +47,13 @@ type OrchestratorWorkflowModel struct {
 
 	ForceDelete  types.Bool `tfsdk:"force_delete"`
 	WaitImported types.Bool `tfsdk:"wait_imported"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// OrchestratorWorkflowDataSourceModel describes the data source data model.
+type OrchestratorWorkflowDataSourceModel struct {
+	OrchestratorWorkflowSummaryModel
 }
 
 // OrchestratorWorkflowCreateAPIModel describes the resource create API model.
@@ -143,6 +151,10 @@ func (self OrchestratorWorkflowModel) LockKey() string {
 	return "orchestrator-workflow-" + self.Id.ValueString()
 }
 
+func (self OrchestratorWorkflowModel) ListPath() string {
+	return "vco/api/workflows"
+}
+
 func (self OrchestratorWorkflowModel) CreatePath() string {
 	return "vco/api/workflows"
 }
@@ -182,6 +194,52 @@ func (self OrchestratorWorkflowModel) DeletePath() string {
 }
 
 // Save response from create API endpoint.
+func (self OrchestratorWorkflowDataSourceModel) String() string {
+	return fmt.Sprintf(
+		"Orchestrator Workflow (id %q, name %q, category %q)",
+		self.Id.ValueString(),
+		self.Name.ValueString(),
+		self.CategoryId.ValueString())
+}
+
+// Return the query retrieving the workflows with the identifier or the name of the workflow to
+// lookup.
+func (self OrchestratorWorkflowDataSourceModel) ListQuery() ListQuery {
+	condition := "name=" + self.Name.ValueString()
+	if len(self.Id.ValueString()) > 0 {
+		condition = "id=" + self.Id.ValueString()
+	}
+	return ListQuery{
+		Path:       OrchestratorWorkflowModel{}.ListPath(),
+		Pagination: LIST_PAGINATION_VRO,
+		Filter:     condition,
+	}
+}
+
+// Return true if the workflow is matching the identifier or name and the category (if set).
+func (self OrchestratorWorkflowDataSourceModel) Match(
+	raw OrchestratorWorkflowSummaryAPIModel,
+) bool {
+	categoryId := self.CategoryId.ValueString()
+	if len(categoryId) > 0 && raw.CategoryId != categoryId {
+		return false
+	}
+	if len(self.Id.ValueString()) > 0 {
+		return raw.Id == self.Id.ValueString()
+	}
+	return raw.Name == self.Name.ValueString()
+}
+
+// Refresh the attributes from the content of the workflow (but the category, kept as listed).
+// FIXME https://github.com/davidfischer-ch/terraform-provider-aria/issues/122
+func (self *OrchestratorWorkflowDataSourceModel) FromContentAPI(
+	raw OrchestratorWorkflowContentAPIModel,
+) {
+	self.Name = types.StringValue(raw.Name)
+	self.Description = types.StringValue(raw.Description)
+	self.Version = types.StringValue(raw.Version)
+}
+
 func (self *OrchestratorWorkflowModel) FromCreateAPI(raw OrchestratorWorkflowCreateAPIModel) {
 	self.Id = types.StringValue(raw.Id)
 	self.Name = types.StringValue(raw.Name)
@@ -40,7 +40,7 @@ func (self *OrchestratorWorkflowResource) Schema(
 	req resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
-	resp.Schema = OrchestratorWorkflowSchema()
+	resp.Schema = OrchestratorWorkflowSchema(ctx)
 }
 
 func (self *OrchestratorWorkflowResource) Configure(
@@ -63,9 +63,21 @@ func (self *OrchestratorWorkflowResource) Create(
 		return
 	}
 
+	// Concurrent operations on the same instance must replay their own API calls
+	ctx = WithCassetteOperation(ctx, workflow.String())
+
+	createTimeout, timeoutDiags := workflow.Timeouts.Create(ctx, DEFAULT_IMPORT_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var workflowFromCreateAPI OrchestratorWorkflowCreateAPIModel
 	path := workflow.CreatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetBody(workflow.ToCreateAPI()).
 		SetResult(&workflowFromCreateAPI).
 		Post(path)
@@ -92,7 +104,7 @@ func (self *OrchestratorWorkflowResource) Create(
 
 	var workflowFromVersionAPI OrchestratorWorkflowVersionResponseAPIModel
 	path = workflow.UpdatePath()
-	response, err = self.client.R(path).
+	response, err = self.client.R(ctx, path).
 		SetBody(workflowToVersionAPI).
 		SetResult(&workflowFromVersionAPI).
 		Post(path)
@@ -113,7 +125,7 @@ func (self *OrchestratorWorkflowResource) Create(
 
 	// Read content
 	var workflowFromContentAPI OrchestratorWorkflowContentAPIModel
-	found, response, readDiags := self.client.ReadIt(&workflow, &workflowFromContentAPI)
+	found, response, readDiags := self.client.ReadIt(ctx, &workflow, &workflowFromContentAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -122,7 +134,7 @@ func (self *OrchestratorWorkflowResource) Create(
 
 	// Read forms
 	var fromsFromAPI any
-	_, _, readDiags = self.client.ReadIt(&workflow, &fromsFromAPI, workflow.ReadFormPath())
+	_, _, readDiags = self.client.ReadIt(ctx, &workflow, &fromsFromAPI, workflow.ReadFormPath())
 	resp.Diagnostics.Append(readDiags...)
 
 	if resp.Diagnostics.HasError() {
@@ -153,9 +165,11 @@ func (self *OrchestratorWorkflowResource) Read(
 		return
 	}
 
+	ctx = WithCassetteOperation(ctx, workflow.String())
+
 	// Read content
 	var workflowFromContentAPI OrchestratorWorkflowContentAPIModel
-	found, response, readDiags := self.client.ReadIt(&workflow, &workflowFromContentAPI)
+	found, response, readDiags := self.client.ReadIt(ctx, &workflow, &workflowFromContentAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -164,12 +178,12 @@ func (self *OrchestratorWorkflowResource) Read(
 
 	// Read forms
 	var formsFromAPI any
-	_, _, readDiags = self.client.ReadIt(&workflow, &formsFromAPI, workflow.ReadFormPath())
+	_, _, readDiags = self.client.ReadIt(ctx, &workflow, &formsFromAPI, workflow.ReadFormPath())
 	resp.Diagnostics.Append(readDiags...)
 
 	// Read versions
 	var versionsFromAPI OrchestratorWorkflowVersionsAPIModel
-	_, _, readDiags = self.client.ReadIt(&workflow, &versionsFromAPI, workflow.ReadVersionsPath())
+	_, _, readDiags = self.client.ReadIt(ctx, &workflow, &versionsFromAPI, workflow.ReadVersionsPath())
 	resp.Diagnostics.Append(readDiags...)
 
 	if resp.Diagnostics.HasError() {
@@ -195,6 +209,17 @@ func (self *OrchestratorWorkflowResource) Update(
 		return
 	}
 
+	ctx = WithCassetteOperation(ctx, workflow.String())
+
+	updateTimeout, timeoutDiags := workflow.Timeouts.Update(ctx, DEFAULT_IMPORT_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	workflowToVersionAPI, diags := workflow.ToVersionAPI(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -203,7 +228,7 @@ func (self *OrchestratorWorkflowResource) Update(
 
 	var workflowFromVersionAPI OrchestratorWorkflowVersionResponseAPIModel
 	path := workflow.UpdatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetBody(workflowToVersionAPI).
 		SetResult(&workflowFromVersionAPI).
 		Post(path)
@@ -234,9 +259,22 @@ func (self *OrchestratorWorkflowResource) Delete(
 	// Read Terraform prior state data into the model
 	var workflow OrchestratorWorkflowModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &workflow)...)
-	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&workflow)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = WithCassetteOperation(ctx, workflow.String())
+
+	deleteTimeout, timeoutDiags := workflow.Timeouts.Delete(ctx, DEFAULT_DELETE_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(self.client.DeleteIt(ctx, &workflow)...)
 }
 
 func (self *OrchestratorWorkflowResource) ImportState(
@@ -264,17 +302,22 @@ func (self *OrchestratorWorkflowResource) WaitImported(
 	name := workflow.String()
 	tflog.Debug(ctx, fmt.Sprintf("Wait %s to be imported...", name))
 
-	// Poll for the workflow to be imported up to 15 minutes (30 x 30 seconds)
-	maxAttempts := 30
-	for attempt := 0; attempt < maxAttempts; attempt++ {
+	// Poll for the workflow to be imported until the operation times out (see timeouts)
+	interval := self.client.GetPollInterval(ctx, time.Duration(30)*time.Second)
+	for attempt := 0; ; attempt++ {
 		// Poll resource until imported
-		time.Sleep(time.Duration(30) * time.Second)
+		if err := Sleep(ctx, interval); err != nil {
+			diags.Append(InterruptedDiagnostics(
+				err, fmt.Sprintf("waiting for %s to be imported", name))...)
+			return diags
+		}
 		tflog.Debug(
 			ctx,
-			fmt.Sprintf("Poll %d of %d - Check %s is imported...", attempt+1, maxAttempts, name))
+			fmt.Sprintf("Poll %d - Check %s is imported...", attempt+1, name))
 
 		var fromGatewayAPI OrchestratorWorkflowGatewayAPIModel
-		found, _, someDiags := self.client.ReadIt(workflow, &fromGatewayAPI, workflow.ReadGatewayPath())
+		found, _, someDiags := self.client.ReadIt(
+			ctx, workflow, &fromGatewayAPI, workflow.ReadGatewayPath())
 		diags.Append(someDiags...)
 		if !found {
 			continue // Continue polling
@@ -284,9 +327,4 @@ func (self *OrchestratorWorkflowResource) WaitImported(
 		diags.Append(workflow.FromGatewayAPI(ctx, fromGatewayAPI)...)
 		return diags
 	}
-
-	diags.AddError(
-		"Client error",
-		fmt.Sprintf("Timeout while waiting for %s to be imported without errors.", name))
-	return diags
 }
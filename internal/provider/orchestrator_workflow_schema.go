@@ -4,15 +4,17 @@
 package provider
 
 import (
+	"context"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	dataschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 )
 
-func OrchestratorWorkflowSchema() schema.Schema {
+func OrchestratorWorkflowSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
 		MarkdownDescription: "Orchestrator workflow resource",
 		Attributes: map[string]schema.Attribute{
@@ -130,7 +132,8 @@ func OrchestratorWorkflowSchema() schema.Schema {
 			"wait_imported": schema.BoolAttribute{
 				MarkdownDescription: strings.Join([]string{
 					"Wait for the workflow to be imported in the service " +
-						"broker (up to 15 minutes, checked every 30 seconds, default is true).",
+						"broker (up to the create or update timeout, 20 minutes by default, " +
+						"default is true).",
 					"",
 					"The `integration` attribute is set if `wait_imported` is `true`, else `null`.",
 					"",
@@ -148,5 +151,33 @@ func OrchestratorWorkflowSchema() schema.Schema {
 				Default:  booldefault.StaticBool(true),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": TimeoutsSchema(ctx),
+		},
+	}
+}
+
+func OrchestratorWorkflowDataSourceSchema() dataschema.Schema {
+	return dataschema.Schema{
+		MarkdownDescription: "Orchestrator workflow data source, lookup by identifier or name",
+		Attributes: map[string]dataschema.Attribute{
+			"id": OptionalIdentifierSchema(""),
+			"name": dataschema.StringAttribute{
+				MarkdownDescription: "Workflow name (must match exactly one workflow)",
+				Computed:            true,
+				Optional:            true,
+			},
+			"description": ComputedDescriptionSchema(),
+			"version": dataschema.StringAttribute{
+				MarkdownDescription: "Workflow version (e.g. 1.0.0)",
+				Computed:            true,
+			},
+			"category_id": OptionalIdentifierSchema(
+				"Category's identifier (restrict the lookup to the workflows of this category)"),
+			"category_name": dataschema.StringAttribute{
+				MarkdownDescription: "Category's name",
+				Computed:            true,
+			},
+		},
 	}
 }
@@ -0,0 +1,73 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrchestratorWorkflowsDataSource{}
+
+func NewOrchestratorWorkflowsDataSource() datasource.DataSource {
+	return &OrchestratorWorkflowsDataSource{}
+}
+
+// OrchestratorWorkflowsDataSource defines the data source implementation.
+type OrchestratorWorkflowsDataSource struct {
+	client *AriaClient
+}
+
+func (self *OrchestratorWorkflowsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_orchestrator_workflows"
+}
+
+func (self *OrchestratorWorkflowsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = OrchestratorWorkflowsDataSourceSchema()
+}
+
+func (self *OrchestratorWorkflowsDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self *OrchestratorWorkflowsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var workflows OrchestratorWorkflowsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &workflows)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := workflows.ListQuery()
+	workflowsFromAPI, err := ListAll[OrchestratorWorkflowSummaryAPIModel](ctx, self.client, query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to list %s, got error: %s", workflows.String(), err))
+		return
+	}
+
+	// Save workflows into Terraform state
+	workflows.FromAPI(workflowsFromAPI)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &workflows)...)
+}
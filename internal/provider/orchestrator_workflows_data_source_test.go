@@ -0,0 +1,80 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrchestratorWorkflowsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+resource "aria_orchestrator_category" "root" {
+  name      = "TEST_ARIA_PROVIDER_WORKFLOWS"
+  type      = "WorkflowCategory"
+  parent_id = ""
+}
+
+resource "aria_orchestrator_workflow" "test" {
+  name        = "Test Workflows Data Source"
+  description = "Workflow generated by the acceptance tests of Aria provider."
+  category_id = aria_orchestrator_category.root.id
+  version     = "0.1.0"
+
+  position = { x = 100, y = 50 }
+
+  restart_mode            = 1 # resume
+  resume_from_failed_mode = 0 # default
+
+  attrib        = jsonencode([])
+  presentation  = jsonencode({})
+  workflow_item = jsonencode([])
+
+  input_parameters  = []
+  output_parameters = []
+
+  input_forms = jsonencode([{ layout = { pages = [] }, schema = {} }])
+
+  wait_imported = false
+}
+
+data "aria_orchestrator_workflows" "filtered" {
+  filter = "name~${aria_orchestrator_workflow.test.name}"
+
+  lifecycle {
+    postcondition {
+      condition     = join(",", self.workflows[*].id) == aria_orchestrator_workflow.test.id
+      error_message = "Workflows must be [${aria_orchestrator_workflow.test.id}]"
+    }
+  }
+}
+
+data "aria_orchestrator_workflows" "searched" {
+  filter = "name~Test Workflows"
+  search = "NO-WORKFLOW-IS-MATCHING-THIS-SEARCH"
+
+  depends_on = [aria_orchestrator_workflow.test]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aria_orchestrator_workflows.filtered", "workflows.#", "1"),
+					resource.TestCheckResourceAttr("data.aria_orchestrator_workflows.filtered", "workflows.0.name", "Test Workflows Data Source"),
+					resource.TestCheckResourceAttr("data.aria_orchestrator_workflows.filtered", "workflows.0.version", "0.1.0"),
+					resource.TestCheckResourceAttrPair(
+						"data.aria_orchestrator_workflows.filtered", "workflows.0.category_id",
+						"aria_orchestrator_category.root", "id",
+					),
+					resource.TestCheckResourceAttr("data.aria_orchestrator_workflows.filtered", "workflows.0.category_name", "TEST_ARIA_PROVIDER_WORKFLOWS"),
+					resource.TestCheckResourceAttr("data.aria_orchestrator_workflows.searched", "workflows.#", "0"),
+				),
+			},
+		},
+	})
+}
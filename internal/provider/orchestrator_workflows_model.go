@@ -0,0 +1,73 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OrchestratorWorkflowsModel describes the data source data model.
+type OrchestratorWorkflowsModel struct {
+	Filter types.String `tfsdk:"filter"`
+	Search types.String `tfsdk:"search"`
+
+	Workflows []OrchestratorWorkflowSummaryModel `tfsdk:"workflows"`
+}
+
+// OrchestratorWorkflowSummaryModel describes the workflows returned by the data source.
+type OrchestratorWorkflowSummaryModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Version      types.String `tfsdk:"version"`
+	CategoryId   types.String `tfsdk:"category_id"`
+	CategoryName types.String `tfsdk:"category_name"`
+}
+
+// OrchestratorWorkflowSummaryAPIModel describes the attributes of the workflows links.
+type OrchestratorWorkflowSummaryAPIModel struct {
+	Id           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Version      string `json:"version"`
+	CategoryId   string `json:"categoryId"`
+	CategoryName string `json:"categoryName"`
+}
+
+func (self OrchestratorWorkflowsModel) String() string {
+	return fmt.Sprintf(
+		"Orchestrator Workflows (filter %q, search %q)",
+		self.Filter.ValueString(),
+		self.Search.ValueString())
+}
+
+func (self OrchestratorWorkflowsModel) ListQuery() ListQuery {
+	return ListQuery{
+		Path:       OrchestratorWorkflowModel{}.ListPath(),
+		Pagination: LIST_PAGINATION_VRO,
+		Filter:     self.Filter.ValueString(),
+	}
+}
+
+func (self *OrchestratorWorkflowsModel) FromAPI(raws []OrchestratorWorkflowSummaryAPIModel) {
+	self.Workflows = []OrchestratorWorkflowSummaryModel{}
+	for _, raw := range raws {
+		if MatchSearch(self.Search.ValueString(), raw.Name) {
+			workflow := OrchestratorWorkflowSummaryModel{}
+			workflow.FromAPI(raw)
+			self.Workflows = append(self.Workflows, workflow)
+		}
+	}
+}
+
+func (self *OrchestratorWorkflowSummaryModel) FromAPI(raw OrchestratorWorkflowSummaryAPIModel) {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Description = types.StringValue(raw.Description)
+	self.Version = types.StringValue(raw.Version)
+	self.CategoryId = types.StringValue(raw.CategoryId)
+	self.CategoryName = types.StringValue(raw.CategoryName)
+}
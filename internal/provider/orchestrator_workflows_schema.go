@@ -0,0 +1,45 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func OrchestratorWorkflowsDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Orchestrator workflows data source (all pages)",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "Conditions sent to the API (e.g. `name~Mail` for names " +
+					"containing Mail or `categoryId=<id>`)",
+				Optional: true,
+			},
+			"search": ListSearchSchema(),
+			"workflows": schema.ListNestedAttribute{
+				MarkdownDescription: "Workflows",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": ComputedIdentifierSchema(""),
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Workflow name (e.g. Send Mail)",
+							Computed:            true,
+						},
+						"description": ComputedDescriptionSchema(),
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Workflow version (e.g. 1.0.0)",
+							Computed:            true,
+						},
+						"category_id": ComputedIdentifierSchema("Category's identifier"),
+						"category_name": schema.StringAttribute{
+							MarkdownDescription: "Category's name",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
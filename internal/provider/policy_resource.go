@@ -69,7 +69,7 @@ func (self *PolicyResource) Create(
 
 	var policyFromAPI PolicyAPIModel
 	path := policy.CreatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetBody(policyToAPI).
 		SetResult(&policyFromAPI).
 		Post(path)
@@ -100,7 +100,7 @@ func (self *PolicyResource) Read(
 	}
 
 	var policyFromAPI PolicyAPIModel
-	found, _, readDiags := self.client.ReadIt(&policy, &policyFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &policy, &policyFromAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -136,7 +136,7 @@ func (self *PolicyResource) Update(
 
 	var policyFromAPI PolicyAPIModel
 	path := policy.UpdatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetBody(policyToAPI).
 		SetResult(&policyFromAPI).
 		Post(path)
@@ -163,7 +163,7 @@ func (self *PolicyResource) Delete(
 	var policy PolicyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &policy)...)
 	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&policy)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &policy)...)
 	}
 }
 
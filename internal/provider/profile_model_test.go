@@ -0,0 +1,34 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestProfileModels_ToAPI_EmptyMapping(t *testing.T) {
+	// An empty mapping must be sent to clear the mappings (instead of keeping the existing ones)
+	cases := []struct {
+		name     string
+		raw      any
+		expected string
+	}{
+		{"flavor profile", FlavorProfileModel{}.ToAPI(), `"flavorMapping":{}`},
+		{"image profile", ImageProfileModel{}.ToAPI(), `"imageMapping":{}`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := json.Marshal(tc.raw)
+			if err != nil {
+				t.Fatalf("Unable to marshal %s, got error: %s", tc.name, err)
+			}
+			if !strings.Contains(string(body), tc.expected) {
+				t.Errorf("Expected %s in %s", tc.expected, body)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProjectConstraintModel describes the resource data model.
+type ProjectConstraintModel struct {
+	Expression types.String `tfsdk:"expression"`
+	Mandatory  types.Bool   `tfsdk:"mandatory"`
+}
+
+// ProjectConstraintAPIModel describes the resource API model.
+type ProjectConstraintAPIModel struct {
+	Expression string `json:"expression"`
+	Mandatory  bool   `json:"mandatory"`
+}
+
+func (self ProjectConstraintModel) String() string {
+	return fmt.Sprintf(
+		"Project Constraint %s (mandatory %t)",
+		self.Expression.ValueString(),
+		self.Mandatory.ValueBool())
+}
+
+func (self *ProjectConstraintModel) FromAPI(raw ProjectConstraintAPIModel) {
+	self.Expression = types.StringValue(raw.Expression)
+	self.Mandatory = types.BoolValue(raw.Mandatory)
+}
+
+func (self ProjectConstraintModel) ToAPI() ProjectConstraintAPIModel {
+	return ProjectConstraintAPIModel{
+		Expression: self.Expression.ValueString(),
+		Mandatory:  self.Mandatory.ValueBool(),
+	}
+}
+
+// Utils -------------------------------------------------------------------------------------------
+
+// Used to convert structure to a types.Object.
+func (self ProjectConstraintModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"expression": types.StringType,
+		"mandatory":  types.BoolType,
+	}
+}
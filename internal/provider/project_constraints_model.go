@@ -3,21 +3,95 @@
 
 package provider
 
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
 // ProjectConstraintsModel describes the resource data model.
 type ProjectConstraintsModel struct {
+	Network       types.List `tfsdk:"network"`
+	Storage       types.List `tfsdk:"storage"`
+	Extensibility types.List `tfsdk:"extensibility"`
+	// Of type ProjectConstraintModel
 }
 
 // ProjectConstraintsAPIModel describes the resource API model.
 type ProjectConstraintsAPIModel struct {
+	Network       []ProjectConstraintAPIModel `json:"network"`
+	Storage       []ProjectConstraintAPIModel `json:"storage"`
+	Extensibility []ProjectConstraintAPIModel `json:"extensibility"`
 }
 
 func (self ProjectConstraintsModel) String() string {
 	return "Project Constraints"
 }
 
-func (self *ProjectConstraintsModel) FromAPI(raw ProjectConstraintsAPIModel) {
+func (self *ProjectConstraintsModel) FromAPI(
+	ctx context.Context,
+	raw ProjectConstraintsAPIModel,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	for _, kind := range []struct {
+		list *types.List
+		raws []ProjectConstraintAPIModel
+	}{
+		{&self.Network, raw.Network},
+		{&self.Storage, raw.Storage},
+		{&self.Extensibility, raw.Extensibility},
+	} {
+		constraints := []ProjectConstraintModel{}
+		for _, constraintRaw := range kind.raws {
+			constraint := ProjectConstraintModel{}
+			constraint.FromAPI(constraintRaw)
+			constraints = append(constraints, constraint)
+		}
+		var someDiags diag.Diagnostics
+		*kind.list, someDiags = types.ListValueFrom(ctx, ProjectConstraintType(), constraints)
+		diags.Append(someDiags...)
+	}
+	return diags
+}
+
+func (self ProjectConstraintsModel) ToAPI(
+	ctx context.Context,
+) (ProjectConstraintsAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	raw := ProjectConstraintsAPIModel{}
+	for _, kind := range []struct {
+		list types.List
+		raws *[]ProjectConstraintAPIModel
+	}{
+		{self.Network, &raw.Network},
+		{self.Storage, &raw.Storage},
+		{self.Extensibility, &raw.Extensibility},
+	} {
+		// Extract constraints from list value and then convert to raw
+		*kind.raws = []ProjectConstraintAPIModel{}
+		constraints := make([]ProjectConstraintModel, 0, len(kind.list.Elements()))
+		diags.Append(kind.list.ElementsAs(ctx, &constraints, false)...)
+		for _, constraint := range constraints {
+			*kind.raws = append(*kind.raws, constraint.ToAPI())
+		}
+	}
+	return raw, diags
+}
+
+// Utils -------------------------------------------------------------------------------------------
+
+// Used to convert structure to a types.Object.
+func (self ProjectConstraintsModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"network":       types.ListType{ElemType: ProjectConstraintType()},
+		"storage":       types.ListType{ElemType: ProjectConstraintType()},
+		"extensibility": types.ListType{ElemType: ProjectConstraintType()},
+	}
 }
 
-func (self ProjectConstraintsModel) ToAPI() ProjectConstraintsAPIModel {
-	return ProjectConstraintsAPIModel{}
+// Return the type of the elements of the lists of constraints.
+func ProjectConstraintType() types.ObjectType {
+	return types.ObjectType{AttrTypes: ProjectConstraintModel{}.AttributeTypes()}
 }
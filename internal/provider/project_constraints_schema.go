@@ -4,13 +4,57 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func ProjectConstraintsSchema() schema.SingleNestedAttribute {
+	emptyList := types.ListValueMust(ProjectConstraintType(), []attr.Value{})
 	return schema.SingleNestedAttribute{
-		MarkdownDescription: "Project constraints",
+		MarkdownDescription: "Project constraints (restrict the placement of the resources " +
+			"to the matching networks, storages or extensibility integrations)",
+		Computed: true,
+		Optional: true,
+		Default: objectdefault.StaticValue(types.ObjectValueMust(
+			ProjectConstraintsModel{}.AttributeTypes(),
+			map[string]attr.Value{
+				"network":       emptyList,
+				"storage":       emptyList,
+				"extensibility": emptyList,
+			},
+		)),
+		Attributes: map[string]schema.Attribute{
+			"network":       ProjectConstraintListSchema("Network constraints"),
+			"storage":       ProjectConstraintListSchema("Storage constraints"),
+			"extensibility": ProjectConstraintListSchema("Extensibility constraints"),
+		},
+	}
+}
+
+func ProjectConstraintListSchema(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: description + " (default is none)",
+		Computed:            true,
 		Optional:            true,
-		Attributes:          map[string]schema.Attribute{},
+		Default: listdefault.StaticValue(
+			types.ListValueMust(ProjectConstraintType(), []attr.Value{})),
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"expression": schema.StringAttribute{
+					MarkdownDescription: "Tag expression (e.g. `env:dev` or `!deprecated`)",
+					Required:            true,
+				},
+				"mandatory": schema.BoolAttribute{
+					MarkdownDescription: "Hard (true, the default) or soft (false) constraint",
+					Computed:            true,
+					Optional:            true,
+					Default:             booldefault.StaticBool(true),
+				},
+			},
+		},
 	}
 }
@@ -0,0 +1,104 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectDataSource{}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &ProjectDataSource{}
+}
+
+// ProjectDataSource defines the data source implementation.
+type ProjectDataSource struct {
+	client *AriaClient
+}
+
+func (self *ProjectDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (self *ProjectDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = ProjectDataSourceSchema()
+}
+
+func (self *ProjectDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self ProjectDataSource) ConfigValidators(
+	ctx context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (self *ProjectDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var project ProjectDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &project)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Lookup the project by name (names are unique, but the filter may be case insensitive)
+	if len(project.Id.ValueString()) == 0 {
+		name := project.Name.ValueString()
+		projectRaw, err := FindOne(ctx, self.client, project.ListQuery(),
+			func(raw ProjectAPIModel) bool { return raw.Name == name })
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client error",
+				fmt.Sprintf("Unable to find %s, got error: %s", project.String(), err))
+			return
+		}
+		project.Id = types.StringValue(projectRaw.Id)
+	}
+
+	var projectFromAPI ProjectAPIModel
+	found, _, readDiags := self.client.ReadIt(ctx, ProjectModel{Id: project.Id}, &projectFromAPI)
+	resp.Diagnostics.Append(readDiags...)
+	if !found {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to find %s, got error: not found", project.String()))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save project into Terraform state
+	resp.Diagnostics.Append(project.FromAPI(ctx, projectFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &project)...)
+}
@@ -5,10 +5,19 @@ package provider
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// Roles granted to the principals of a project (each role has its own list of principals).
+var PROJECT_ROLES = []string{"administrator", "member", "supervisor", "viewer"}
+
+// Types of principals.
+var PROJECT_PRINCIPAL_TYPES = []string{"user", "group"}
+
 // ProjectMembershipModel describes the resource data model.
 type ProjectMembershipModel struct {
 	Email types.String `tfsdk:"email"`
@@ -23,6 +32,35 @@ type ProjectMembershipAPIModel struct {
 	Role  string `json:"role"`
 }
 
+// ProjectMembershipResourceModel describes the resource data model (a single principal of a
+// project, the other principals are left untouched).
+type ProjectMembershipResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	ProjectId types.String `tfsdk:"project_id"`
+
+	ProjectMembershipModel
+}
+
+// ProjectPrincipalsChangesAPIModel describes the principals to add (or modify) and to remove.
+type ProjectPrincipalsChangesAPIModel struct {
+	Modify []ProjectMembershipAPIModel `json:"modify"`
+	Remove []ProjectPrincipalAPIModel  `json:"remove"`
+}
+
+// ProjectPrincipalAPIModel describes the principals as listed by role in the project API model.
+type ProjectPrincipalAPIModel struct {
+	Email string `json:"email"`
+	Type  string `json:"type"`
+}
+
+// ProjectPrincipalsAPIModel describes the principals of the project API model, by role.
+type ProjectPrincipalsAPIModel struct {
+	Administrators []ProjectPrincipalAPIModel `json:"administrators"`
+	Members        []ProjectPrincipalAPIModel `json:"members"`
+	Supervisors    []ProjectPrincipalAPIModel `json:"supervisors"`
+	Viewers        []ProjectPrincipalAPIModel `json:"viewers"`
+}
+
 func (self ProjectMembershipModel) String() string {
 	return fmt.Sprintf(
 		"Project %s Membership %s %s",
@@ -31,6 +69,93 @@ func (self ProjectMembershipModel) String() string {
 		self.Email.ValueString())
 }
 
+func (self ProjectMembershipResourceModel) String() string {
+	return fmt.Sprintf(
+		"Project %s %s %s %s",
+		self.ProjectId.ValueString(),
+		self.Role.ValueString(),
+		self.Type.ValueString(),
+		self.Email.ValueString())
+}
+
+// Return an appropriate key that can be used for naming mutexes.
+// Create Read Update Delete: Identifier can be used to prevent concurrent modifications on the
+// principals of the project.
+func (self ProjectMembershipResourceModel) LockKey() string {
+	return "project-" + self.ProjectId.ValueString()
+}
+
+func (self ProjectMembershipResourceModel) CreatePath() string {
+	return "project-service/api/projects/" + self.ProjectId.ValueString() + "/principals"
+}
+
+// The principals are read from the project.
+func (self ProjectMembershipResourceModel) ReadPath() string {
+	return ProjectModel{Id: self.ProjectId}.ReadPath()
+}
+
+func (self ProjectMembershipResourceModel) UpdatePath() string {
+	return self.CreatePath()
+}
+
+func (self ProjectMembershipResourceModel) DeletePath() string {
+	return self.CreatePath()
+}
+
+// Identifier of the membership: project_id/type/email.
+func (self ProjectMembershipResourceModel) ComputeId() types.String {
+	return types.StringValue(strings.Join([]string{
+		self.ProjectId.ValueString(),
+		self.Type.ValueString(),
+		self.Email.ValueString(),
+	}, "/"))
+}
+
+// Set project_id, type and email from the identifier (e.g. when importing).
+func (self *ProjectMembershipResourceModel) ParseId(id string) error {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || slices.Contains(parts, "") {
+		return fmt.Errorf("identifier %q must be formatted as project_id/type/email", id)
+	}
+	self.Id = types.StringValue(id)
+	self.ProjectId = types.StringValue(parts[0])
+	self.Type = types.StringValue(parts[1])
+	self.Email = types.StringValue(parts[2])
+	return nil
+}
+
+// Update the role from the principals of the project, return false if the principal is missing.
+func (self *ProjectMembershipResourceModel) FromAPI(raw ProjectPrincipalsAPIModel) bool {
+	for _, membership := range raw.ToMemberships() {
+		if strings.EqualFold(membership.Email.ValueString(), self.Email.ValueString()) &&
+			membership.Type.Equal(self.Type) {
+			self.Role = membership.Role
+			self.Id = self.ComputeId()
+			return true
+		}
+	}
+	return false
+}
+
+// Return the changes adding the principal (or modifying its role).
+func (self ProjectMembershipResourceModel) ToAPI() ProjectPrincipalsChangesAPIModel {
+	return ProjectPrincipalsChangesAPIModel{
+		Modify: []ProjectMembershipAPIModel{self.ProjectMembershipModel.ToAPI()},
+		Remove: []ProjectPrincipalAPIModel{},
+	}
+}
+
+// Return the changes removing the principal.
+func (self ProjectMembershipResourceModel) ToRemoveAPI() ProjectPrincipalsChangesAPIModel {
+	return ProjectPrincipalsChangesAPIModel{
+		Modify: []ProjectMembershipAPIModel{},
+		Remove: []ProjectPrincipalAPIModel{{
+			Email: self.Email.ValueString(),
+			Type:  self.Type.ValueString(),
+		}},
+	}
+}
+
 func (self *ProjectMembershipModel) FromAPI(raw ProjectMembershipAPIModel) {
 	self.Email = types.StringValue(raw.Email)
 	self.Type = types.StringValue(raw.Type)
@@ -44,3 +169,66 @@ func (self ProjectMembershipModel) ToAPI() ProjectMembershipAPIModel {
 		Role:  self.Role.ValueString(),
 	}
 }
+
+// Return the list of principals of given role (a pointer to the list, to append to it).
+func (self *ProjectPrincipalsAPIModel) Role(role string) *[]ProjectPrincipalAPIModel {
+	switch role {
+	case "administrator":
+		return &self.Administrators
+	case "member":
+		return &self.Members
+	case "supervisor":
+		return &self.Supervisors
+	case "viewer":
+		return &self.Viewers
+	}
+	panic(fmt.Sprintf("Internal error: Project role %s is not supported.", role))
+}
+
+// Convert the principals (listed by role) to memberships.
+func (self ProjectPrincipalsAPIModel) ToMemberships() []ProjectMembershipModel {
+	memberships := []ProjectMembershipModel{}
+	for _, role := range PROJECT_ROLES {
+		for _, principal := range *self.Role(role) {
+			membership := ProjectMembershipModel{}
+			membership.FromAPI(ProjectMembershipAPIModel{
+				Email: principal.Email,
+				Type:  principal.Type,
+				Role:  role,
+			})
+			memberships = append(memberships, membership)
+		}
+	}
+	return memberships
+}
+
+// Convert the memberships to principals (listed by role).
+func ProjectPrincipalsFromMemberships(
+	memberships []ProjectMembershipModel,
+) ProjectPrincipalsAPIModel {
+	principals := ProjectPrincipalsAPIModel{
+		Administrators: []ProjectPrincipalAPIModel{},
+		Members:        []ProjectPrincipalAPIModel{},
+		Supervisors:    []ProjectPrincipalAPIModel{},
+		Viewers:        []ProjectPrincipalAPIModel{},
+	}
+	for _, membership := range memberships {
+		principalsOfRole := principals.Role(membership.Role.ValueString())
+		*principalsOfRole = append(*principalsOfRole, ProjectPrincipalAPIModel{
+			Email: membership.Email.ValueString(),
+			Type:  membership.Type.ValueString(),
+		})
+	}
+	return principals
+}
+
+// Utils -------------------------------------------------------------------------------------------
+
+// Used to convert structure to a types.Object.
+func (self ProjectMembershipModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"email": types.StringType,
+		"type":  types.StringType,
+		"role":  types.StringType,
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProjectMembershipResourceModelFromAPI(t *testing.T) {
+	raw := ProjectPrincipalsAPIModel{
+		Members: []ProjectPrincipalAPIModel{{Email: "John.Doe@example.com", Type: "user"}},
+	}
+	cases := []struct {
+		name         string
+		email        string
+		principal    string
+		expected     bool
+		expectedRole string
+	}{
+		{"same email", "John.Doe@example.com", "user", true, "member"},
+		{"email of another case", "john.doe@example.com", "user", true, "member"},
+		{"another type", "John.Doe@example.com", "group", false, ""},
+		{"another email", "jane.doe@example.com", "user", false, ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			membership := ProjectMembershipResourceModel{ProjectId: types.StringValue("some-project")}
+			membership.Email = types.StringValue(tc.email)
+			membership.Type = types.StringValue(tc.principal)
+			CheckEqual(t, membership.FromAPI(raw), tc.expected)
+			CheckEqual(t, membership.Role.ValueString(), tc.expectedRole)
+			if tc.expected {
+				// The configured email is kept (no diff)
+				CheckEqual(t, membership.Email.ValueString(), tc.email)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectMembershipResource{}
+var _ resource.ResourceWithImportState = &ProjectMembershipResource{}
+
+func NewProjectMembershipResource() resource.Resource {
+	return &ProjectMembershipResource{}
+}
+
+// ProjectMembershipResource defines the resource implementation.
+type ProjectMembershipResource struct {
+	client *AriaClient
+}
+
+func (self *ProjectMembershipResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project_membership"
+}
+
+func (self *ProjectMembershipResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = ProjectMembershipResourceSchema()
+}
+
+func (self *ProjectMembershipResource) Configure(
+	ctx context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	self.client = GetResourceClient(ctx, req, resp)
+}
+
+func (self *ProjectMembershipResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	// Read Terraform plan data into the model
+	var membership ProjectMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &membership)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(self.ChangePrincipals(ctx, membership, membership.ToAPI())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save membership into Terraform state
+	membership.Id = membership.ComputeId()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &membership)...)
+	tflog.Debug(ctx, fmt.Sprintf("Created %s successfully", membership.String()))
+}
+
+func (self *ProjectMembershipResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	// Read Terraform prior state data into the model
+	var membership ProjectMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &membership)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projectFromAPI ProjectAPIModel
+	found, _, readDiags := self.client.ReadIt(ctx, &membership, &projectFromAPI)
+	resp.Diagnostics.Append(readDiags...)
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the managed principal is looked at, it may have been removed from the project
+	principalsFromAPI := ProjectPrincipalsAPIModel{}
+	if projectFromAPI.ProjectPrincipalsAPIModel != nil {
+		principalsFromAPI = *projectFromAPI.ProjectPrincipalsAPIModel
+	}
+	if !membership.FromAPI(principalsFromAPI) {
+		tflog.Debug(ctx, fmt.Sprintf("%s not found", membership.String()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated membership into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &membership)...)
+}
+
+func (self *ProjectMembershipResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	// Read Terraform plan data into the model
+	var membership ProjectMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &membership)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(self.ChangePrincipals(ctx, membership, membership.ToAPI())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated membership into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &membership)...)
+	tflog.Debug(ctx, fmt.Sprintf("Updated %s successfully", membership.String()))
+}
+
+func (self *ProjectMembershipResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	// Read Terraform prior state data into the model
+	var membership ProjectMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &membership)...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(
+			self.ChangePrincipals(ctx, membership, membership.ToRemoveAPI())...)
+	}
+}
+
+func (self *ProjectMembershipResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	var membership ProjectMembershipResourceModel
+	if err := membership.ParseId(req.ID); err != nil {
+		resp.Diagnostics.AddError("Configuration error", fmt.Sprintf("Unable to import: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &membership)...)
+}
+
+// -------------------------------------------------------------------------------------------------
+
+// Apply the changes to the principals of the project, the other principals are left untouched.
+// Changes are serialized per project (the memberships of a project are often managed together).
+func (self *ProjectMembershipResource) ChangePrincipals(
+	ctx context.Context,
+	membership ProjectMembershipResourceModel,
+	changes ProjectPrincipalsChangesAPIModel,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	path := membership.UpdatePath()
+
+	self.client.Mutex.Lock(ctx, membership.LockKey())
+	response, err := self.client.R(ctx, path).
+		SetQueryParam("validatePrincipals", "true").
+		SetBody(changes).
+		Patch(path)
+	self.client.Mutex.Unlock(ctx, membership.LockKey())
+
+	err = self.client.HandleAPIResponse(response, err, []int{200, 204})
+	if err != nil {
+		diags.AddError(
+			"Client error",
+			fmt.Sprintf(
+				"Unable to change principals of %s, got error: %s", membership.String(), err))
+	}
+	return diags
+}
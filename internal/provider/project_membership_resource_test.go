@@ -0,0 +1,79 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectMembershipResource(t *testing.T) {
+	config := func(role string) string {
+		return fmt.Sprintf(`
+variable "test_user_email" {
+  description = "User granted a role on the project."
+  type        = string
+}
+
+resource "aria_project" "test" {
+  name              = "ARIA_PROVIDER_TEST_PROJECT_MEMBERSHIP"
+  operation_timeout = 0
+  shared_resources  = true
+  constraints       = {}
+  properties        = {}
+}
+
+resource "aria_project_membership" "test" {
+  project_id = aria_project.test.id
+  email      = var.test_user_email
+  type       = "user"
+  role       = %q
+}
+`, role)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("viewer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("aria_project_membership.test", "id"),
+					resource.TestCheckResourceAttrPair(
+						"aria_project_membership.test", "project_id",
+						"aria_project.test", "id",
+					),
+					resource.TestCheckResourceAttr("aria_project_membership.test", "type", "user"),
+					resource.TestCheckResourceAttr("aria_project_membership.test", "role", "viewer"),
+				),
+			},
+			// Update testing
+			{
+				Config: config("member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_project_membership.test", "role", "member"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "aria_project_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "aria_project_membership.test",
+				ImportState:   true,
+				ImportStateId: "some-project-id",
+				ExpectError: regexp.MustCompile(
+					`must\s+be\s+formatted\s+as\s+project_id/type/email`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
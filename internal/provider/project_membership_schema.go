@@ -3,7 +3,9 @@
 
 package provider
 
-/* import (
+import (
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -11,49 +13,63 @@ package provider
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+func ProjectMembershipResourceSchema() schema.Schema {
+	// Same attributes as the memberships of the project, the principal cannot be changed
+	attributes := ProjectMembershipSchema().Attributes
+	for _, key := range []string{"email", "type"} {
+		attribute := attributes[key].(schema.StringAttribute)
+		attribute.MarkdownDescription += IMMUTABLE
+		attribute.PlanModifiers = []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		}
+		attributes[key] = attribute
+	}
+	attributes["id"] = ComputedIdentifierSchema(
+		"Identifier (project identifier, principal type and email separated by slashes)")
+	attributes["project_id"] = RequiredImmutableIdentifierSchema("Project identifier" + IMMUTABLE)
+
+	return schema.Schema{
+		MarkdownDescription: strings.Join([]string{
+			"Project membership resource, grant a role on a project to a user or a group.",
+			"Only the given principal is managed, the other principals of the project are " +
+				"left untouched (omit `memberships` of the `aria_project` to prevent conflicts).",
+		}, "\n"),
+		Attributes: attributes,
+	}
+}
+
 // Named ProjectPrincipalsAssignment in Project's API Swagger
 func ProjectMembershipSchema() schema.NestedAttributeObject {
 	return schema.NestedAttributeObject{
-		MarkdownDescription: "A project membership granted to a user or group",
 		Attributes: map[string]schema.Attribute{
 			"email": schema.StringAttribute{
-				MarkdownDescription: strings.Join(",", []string{
-					"example: administrator@vmware.com",
-					"The username of the user or display name of the group. "+
-					"When assigning a group, the email is expected to have the format "+
-					"displayName@domain. In the case where the display name in Identity provider "+
-					"is in the format:",
+				MarkdownDescription: strings.Join([]string{
+					"The username of the user or display name of the group (e.g. " +
+						"administrator@vmware.com).",
+					"When assigning a group, the email is expected to have the format " +
+						"displayName@domain. In the case where the display name in Identity " +
+						"provider is in the format:",
 					"* name@domain - email should be written as name@domain@domain",
 					"* name (and group has domain) - email should be written as name@domain",
 					"* name (and group doesn't have domain) - email should be written as name@",
-					"",
-					"to ensure proper functioning." + IMMUTABLE,
-				}),
-				Required:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				}, "\n"),
+				Required: true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "Principal type, either user or group",
+				MarkdownDescription: "Principal type, either `user` or `group`",
 				Required:            true,
 				Validators: []validator.String{
-					stringvalidator.OneOf([]string{"user", "group"}...),
+					stringvalidator.OneOf(PROJECT_PRINCIPAL_TYPES...),
 				},
 			},
 			"role": schema.StringAttribute{
-				MarkdownDescription: "Access level, one of administrator, member, supervisor or "+
-					" viewer",
+				MarkdownDescription: "Access level, one of `administrator`, `member`, " +
+					"`supervisor` or `viewer`",
 				Required: true,
 				Validators: []validator.String{
-					stringvalidator.OneOf([]string{
-						"administrator",
-						"member",
-						"supervisor",
-						"viewer",
-					}...),
+					stringvalidator.OneOf(PROJECT_ROLES...),
 				},
 			},
 		},
 	}
-} */
+}
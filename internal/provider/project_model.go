@@ -6,11 +6,19 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// Placement policies of the resources (spread by number of resources or by memory).
+var PROJECT_PLACEMENT_POLICIES = []string{"DEFAULT", "SPREAD", "SPREAD_MEMORY"}
+
+// Property holding the placement policy (hidden from the properties of the project).
+const PROJECT_PLACEMENT_POLICY_PROPERTY = "__projectPlacementPolicy"
+
 // ProjectModel describes the resource data model.
 type ProjectModel struct {
 	Id               types.String `tfsdk:"id"`
@@ -18,11 +26,27 @@ type ProjectModel struct {
 	OperationTimeout types.Int32  `tfsdk:"operation_timeout"`
 	SharedResources  types.Bool   `tfsdk:"shared_resources"`
 
-	Constraints ProjectConstraintsModel `tfsdk:"constraints"`
-	Properties  types.Map               `tfsdk:"properties"`
+	Memberships types.Set `tfsdk:"memberships"`
+	// Of type ProjectMembershipModel
+
+	Zones types.List `tfsdk:"zones"`
+	// Of type ProjectZoneModel
+
+	PlacementPolicy       types.String            `tfsdk:"placement_policy"`
+	Constraints           ProjectConstraintsModel `tfsdk:"constraints"`
+	MachineNamingTemplate types.String            `tfsdk:"machine_naming_template"`
+	Properties            types.Map               `tfsdk:"properties"`
 	/*Cost ProjectCostModel `tfsdk:"cost"`*/
 
 	OrgId types.String `tfsdk:"org_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ProjectDataSourceModel describes the data source data model.
+type ProjectDataSourceModel struct {
+	ProjectSummaryModel
+	Properties types.Map `tfsdk:"properties"`
 }
 
 // ProjectAPIModel describes the resource API model.
@@ -32,8 +56,15 @@ type ProjectAPIModel struct {
 	OperationTimeout int32  `json:"operationTimeout"`
 	SharedResources  bool   `json:"sharedResources"`
 
-	Constraints ProjectConstraintsAPIModel `json:"constraints"`
-	Properties  map[string]string          `json:"properties"`
+	// Omitted if the memberships are not managed by the project
+	*ProjectPrincipalsAPIModel
+
+	Zones []ProjectZoneAPIModel `json:"zones"`
+
+	PlacementPolicy       string                     `json:"placementPolicy"`
+	Constraints           ProjectConstraintsAPIModel `json:"constraints"`
+	MachineNamingTemplate string                     `json:"machineNamingTemplate"`
+	Properties            map[string]string          `json:"properties"`
 	/*Cost ProjectCostAPIModel `json:"cost"`*/
 
 	OrgId string `json:"orgId,omitempty"`
@@ -53,6 +84,10 @@ func (self ProjectModel) LockKey() string {
 	return "project-" + self.Id.ValueString()
 }
 
+func (self ProjectModel) ListPath() string {
+	return "project-service/api/projects"
+}
+
 func (self ProjectModel) CreatePath() string {
 	return "project-service/api/projects"
 }
@@ -69,6 +104,32 @@ func (self ProjectModel) DeletePath() string {
 	return self.ReadPath()
 }
 
+func (self ProjectDataSourceModel) String() string {
+	return fmt.Sprintf(
+		"Project (id %q, name %q)",
+		self.Id.ValueString(),
+		self.Name.ValueString())
+}
+
+// Return the query retrieving the projects named like the project to lookup.
+func (self ProjectDataSourceModel) ListQuery() ListQuery {
+	return ListQuery{
+		Path:       ProjectModel{}.ListPath(),
+		Pagination: LIST_PAGINATION_PAGE,
+		Filter:     "name eq " + ODataString(self.Name.ValueString()),
+	}
+}
+
+func (self *ProjectDataSourceModel) FromAPI(
+	ctx context.Context,
+	raw ProjectAPIModel,
+) diag.Diagnostics {
+	self.ProjectSummaryModel.FromAPI(raw)
+	var diags diag.Diagnostics
+	self.Properties, diags = types.MapValueFrom(ctx, types.StringType, raw.Properties)
+	return diags
+}
+
 func (self *ProjectModel) FromAPI(
 	ctx context.Context,
 	raw ProjectAPIModel,
@@ -77,12 +138,50 @@ func (self *ProjectModel) FromAPI(
 	self.Name = types.StringValue(raw.Name)
 	self.OperationTimeout = types.Int32Value(raw.OperationTimeout)
 	self.SharedResources = types.BoolValue(raw.SharedResources)
+	self.MachineNamingTemplate = types.StringValue(raw.MachineNamingTemplate)
 	self.OrgId = types.StringValue(raw.OrgId)
 
-	self.Constraints.FromAPI(raw.Constraints)
-
-	var diags diag.Diagnostics
-	self.Properties, diags = types.MapValueFrom(ctx, types.StringType, raw.Properties)
+	diags := diag.Diagnostics{}
+
+	// Convert principals (listed by role) to a set of memberships
+	principalsRaw := ProjectPrincipalsAPIModel{}
+	if raw.ProjectPrincipalsAPIModel != nil {
+		principalsRaw = *raw.ProjectPrincipalsAPIModel
+	}
+	var someDiags diag.Diagnostics
+	self.Memberships, someDiags = types.SetValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: ProjectMembershipModel{}.AttributeTypes()},
+		principalsRaw.ToMemberships())
+	diags.Append(someDiags...)
+
+	// Convert zones from raw to list
+	zones := []ProjectZoneModel{}
+	for _, zoneRaw := range raw.Zones {
+		zone := ProjectZoneModel{}
+		zone.FromAPI(zoneRaw)
+		zones = append(zones, zone)
+	}
+	self.Zones, someDiags = types.ListValueFrom(
+		ctx, types.ObjectType{AttrTypes: ProjectZoneModel{}.AttributeTypes()}, zones)
+	diags.Append(someDiags...)
+
+	diags.Append(self.Constraints.FromAPI(ctx, raw.Constraints)...)
+
+	// The placement policy is also exposed as a property, hidden to prevent drifts
+	properties := maps.Clone(raw.Properties)
+	placementPolicy := raw.PlacementPolicy
+	if len(placementPolicy) == 0 {
+		placementPolicy = properties[PROJECT_PLACEMENT_POLICY_PROPERTY]
+	}
+	if len(placementPolicy) == 0 {
+		placementPolicy = "DEFAULT"
+	}
+	delete(properties, PROJECT_PLACEMENT_POLICY_PROPERTY)
+	self.PlacementPolicy = types.StringValue(placementPolicy)
+
+	self.Properties, someDiags = types.MapValueFrom(ctx, types.StringType, properties)
+	diags.Append(someDiags...)
 
 	return diags
 }
@@ -91,17 +190,43 @@ func (self ProjectModel) ToAPI(
 	ctx context.Context,
 ) (ProjectAPIModel, diag.Diagnostics) {
 
-	constraintsRaw := self.Constraints.ToAPI()
+	diags := diag.Diagnostics{}
+
+	// Memberships are managed by the project only if they are declared
+	var principalsRaw *ProjectPrincipalsAPIModel
+	if !self.Memberships.IsNull() && !self.Memberships.IsUnknown() {
+		memberships := make([]ProjectMembershipModel, 0, len(self.Memberships.Elements()))
+		diags.Append(self.Memberships.ElementsAs(ctx, &memberships, false)...)
+		principals := ProjectPrincipalsFromMemberships(memberships)
+		principalsRaw = &principals
+	}
+
+	// Extract zones from list value and then convert to raw
+	zonesRaw := []ProjectZoneAPIModel{}
+	zones := make([]ProjectZoneModel, 0, len(self.Zones.Elements()))
+	diags.Append(self.Zones.ElementsAs(ctx, &zones, false)...)
+	for _, zone := range zones {
+		zonesRaw = append(zonesRaw, zone.ToAPI())
+	}
+
+	constraintsRaw, someDiags := self.Constraints.ToAPI(ctx)
+	diags.Append(someDiags...)
+
 	propertiesRaw := make(map[string]string, len(self.Properties.Elements()))
-	diags := self.Properties.ElementsAs(ctx, &propertiesRaw, false)
+	diags.Append(self.Properties.ElementsAs(ctx, &propertiesRaw, false)...)
+	propertiesRaw[PROJECT_PLACEMENT_POLICY_PROPERTY] = self.PlacementPolicy.ValueString()
 
 	return ProjectAPIModel{
-		Id:               self.Id.ValueString(),
-		Name:             self.Name.ValueString(),
-		OperationTimeout: self.OperationTimeout.ValueInt32(),
-		SharedResources:  self.SharedResources.ValueBool(),
-		Constraints:      constraintsRaw,
-		Properties:       propertiesRaw,
-		OrgId:            self.OrgId.ValueString(),
+		Id:                        self.Id.ValueString(),
+		Name:                      self.Name.ValueString(),
+		OperationTimeout:          self.OperationTimeout.ValueInt32(),
+		SharedResources:           self.SharedResources.ValueBool(),
+		ProjectPrincipalsAPIModel: principalsRaw,
+		Zones:                     zonesRaw,
+		PlacementPolicy:           self.PlacementPolicy.ValueString(),
+		Constraints:               constraintsRaw,
+		MachineNamingTemplate:     self.MachineNamingTemplate.ValueString(),
+		Properties:                propertiesRaw,
+		OrgId:                     self.OrgId.ValueString(),
 	}, diags
 }
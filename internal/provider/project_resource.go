@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -38,7 +39,7 @@ func (self *ProjectResource) Schema(
 	req resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
-	resp.Schema = ProjectSchema()
+	resp.Schema = ProjectSchema(ctx)
 }
 
 func (self *ProjectResource) Configure(
@@ -61,6 +62,15 @@ func (self *ProjectResource) Create(
 		return
 	}
 
+	createTimeout, timeoutDiags := project.Timeouts.Create(ctx, DEFAULT_CREATE_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	projectToAPI, diags := project.ToAPI(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -69,7 +79,7 @@ func (self *ProjectResource) Create(
 
 	var projectFromAPI ProjectAPIModel
 	path := project.CreatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetQueryParam("validatePrincipals", "true").
 		SetQueryParam("syncPrincipals", "true").
 		SetBody(projectToAPI).
@@ -102,7 +112,7 @@ func (self *ProjectResource) Read(
 	}
 
 	var projectFromAPI ProjectAPIModel
-	found, _, readDiags := self.client.ReadIt(&project, &projectFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &project, &projectFromAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -130,6 +140,24 @@ func (self *ProjectResource) Update(
 		return
 	}
 
+	updateTimeout, timeoutDiags := project.Timeouts.Update(ctx, DEFAULT_UPDATE_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Memberships are kept from the state if omitted, do not send them (principals may have been
+	// granted meanwhile, e.g. by the aria_project_membership resources applied concurrently)
+	var memberships types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("memberships"), &memberships)...)
+	if memberships.IsNull() {
+		project.Memberships = types.SetUnknown(
+			types.ObjectType{AttrTypes: ProjectMembershipModel{}.AttributeTypes()})
+	}
+
 	projectToAPI, diags := project.ToAPI(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -138,14 +166,13 @@ func (self *ProjectResource) Update(
 
 	var projectFromAPI ProjectAPIModel
 	path := project.UpdatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetQueryParam("validatePrincipals", "true").
 		SetQueryParam("syncPrincipals", "true").
 		SetBody(projectToAPI).
 		SetResult(&projectFromAPI).
 		Patch(path)
 	// TODO Also call PATCH project-service/api/projects/{id}/cost
-	// TODO Also call PATCH project-service/api/projects/{id}/principals
 	// TODO Also call PATCH project-service/api/projects/{id}/resource-metadata
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
@@ -169,9 +196,20 @@ func (self *ProjectResource) Delete(
 	// Read Terraform prior state data into the model
 	var project ProjectModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &project)...)
-	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&project)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, timeoutDiags := project.Timeouts.Delete(ctx, DEFAULT_DELETE_TIMEOUT)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(self.client.DeleteIt(ctx, &project)...)
 }
 
 func (self *ProjectResource) ImportState(
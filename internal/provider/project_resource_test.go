@@ -34,12 +34,86 @@ resource "aria_project" "test" {
 					resource.TestCheckResourceAttr("aria_project.test", "name", "ARIA_PROVIDER_TEST_PROJECT"),
 					resource.TestCheckResourceAttr("aria_project.test", "operation_timeout", "0"),
 					resource.TestCheckResourceAttr("aria_project.test", "shared_resources", "true"),
+					resource.TestCheckResourceAttrSet("aria_project.test", "memberships.#"),
+					resource.TestCheckResourceAttr("aria_project.test", "zones.#", "0"),
+					resource.TestCheckResourceAttr("aria_project.test", "placement_policy", "DEFAULT"),
+					resource.TestCheckResourceAttr("aria_project.test", "constraints.network.#", "0"),
+					resource.TestCheckResourceAttr("aria_project.test", "machine_naming_template", ""),
+					resource.TestCheckResourceAttr("aria_project.test", "properties.%", "1"),
 					resource.TestCheckResourceAttr("aria_project.test", "properties.toto", "tata"),
 					resource.TestCheckResourceAttrSet("aria_project.test", "org_id"),
 				),
 			},
 			// Update testing
-			// TODO
+			{
+				Config: `
+variable "test_user_email" {
+  description = "User granted a role on the project."
+  type        = string
+}
+
+variable "test_zone_id" {
+  description = "Cloud zone assigned to the project."
+  type        = string
+}
+
+resource "aria_project" "test" {
+  name              = "ARIA_PROVIDER_TEST_PROJECT"
+  operation_timeout = 3600
+  shared_resources  = false
+
+  memberships = [
+    {
+      email = var.test_user_email
+      type  = "user"
+      role  = "administrator"
+    }
+  ]
+
+  zones = [
+    {
+      zone_id         = var.test_zone_id
+      priority        = 1
+      max_instances   = 10
+      memory_limit_mb = 16384
+    }
+  ]
+
+  placement_policy = "SPREAD"
+
+  constraints = {
+    network = [{ expression = "env:test" }]
+    storage = [{ expression = "tier:silver", mandatory = false }]
+  }
+
+  machine_naming_template = "$${resource.name}-$${####}"
+
+  properties = {
+    toto = "titi"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aria_project.test", "operation_timeout", "3600"),
+					resource.TestCheckResourceAttr("aria_project.test", "shared_resources", "false"),
+					resource.TestCheckResourceAttr("aria_project.test", "memberships.#", "1"),
+					resource.TestCheckResourceAttr("aria_project.test", "memberships.0.type", "user"),
+					resource.TestCheckResourceAttr("aria_project.test", "memberships.0.role", "administrator"),
+					resource.TestCheckResourceAttr("aria_project.test", "zones.#", "1"),
+					resource.TestCheckResourceAttr("aria_project.test", "zones.0.priority", "1"),
+					resource.TestCheckResourceAttr("aria_project.test", "zones.0.max_instances", "10"),
+					resource.TestCheckResourceAttr("aria_project.test", "zones.0.cpu_limit", "0"),
+					resource.TestCheckResourceAttr("aria_project.test", "zones.0.memory_limit_mb", "16384"),
+					resource.TestCheckResourceAttr("aria_project.test", "placement_policy", "SPREAD"),
+					resource.TestCheckResourceAttr("aria_project.test", "constraints.network.0.expression", "env:test"),
+					resource.TestCheckResourceAttr("aria_project.test", "constraints.network.0.mandatory", "true"),
+					resource.TestCheckResourceAttr("aria_project.test", "constraints.storage.0.mandatory", "false"),
+					resource.TestCheckResourceAttr("aria_project.test", "constraints.extensibility.#", "0"),
+					resource.TestCheckResourceAttr("aria_project.test", "machine_naming_template", "${resource.name}-${####}"),
+					resource.TestCheckResourceAttr("aria_project.test", "properties.%", "1"),
+					resource.TestCheckResourceAttr("aria_project.test", "properties.toto", "titi"),
+				),
+			},
 			// ImportState testing
 			/*{
 				ResourceName:      "aria_project.test",
@@ -6,15 +6,24 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dataschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func ProjectSchema() schema.Schema {
+func ProjectSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
-		MarkdownDescription: "Project resource (WORK IN PROGRESS, DO NOT USE)",
+		MarkdownDescription: "Project resource",
 		Attributes: map[string]schema.Attribute{
 			"id": ComputedIdentifierSchema(""),
 			"name": schema.StringAttribute{
@@ -34,11 +43,47 @@ func ProjectSchema() schema.Schema {
 					"project's members or not",
 				Required: true,
 			},
-			/*"administrators": ProjectMembershipSchema("Administrators"),*/
-			/*"members": ProjectMembershipSchema("Members"),*/
-			/*"viewers": ProjectMembershipSchema("Viewers"),*/
-			/*"supervisors": ProjectMembershipSchema("Supervisors"),*/
+			"memberships": schema.SetNestedAttribute{
+				MarkdownDescription: "Users and groups granted a role on the project " +
+					"(omit it to manage the memberships with `aria_project_membership` " +
+					"or from the UI)",
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: ProjectMembershipSchema(),
+			},
+			"zones": schema.ListNestedAttribute{
+				MarkdownDescription: "Cloud zones where the resources can be provisioned " +
+					"(default is none)",
+				Computed: true,
+				Optional: true,
+				Default: listdefault.StaticValue(types.ListValueMust(
+					types.ObjectType{AttrTypes: ProjectZoneModel{}.AttributeTypes()},
+					[]attr.Value{})),
+				NestedObject: ProjectZoneSchema(),
+			},
+			"placement_policy": schema.StringAttribute{
+				MarkdownDescription: "Placement policy of the resources into the zones, " +
+					"`DEFAULT` (by priority, the default), `SPREAD` (by number of resources) or " +
+					"`SPREAD_MEMORY` (by allocated memory)",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString("DEFAULT"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(PROJECT_PLACEMENT_POLICIES...),
+				},
+			},
 			"constraints": ProjectConstraintsSchema(),
+			"machine_naming_template": schema.StringAttribute{
+				MarkdownDescription: "Template used to name the machines (e.g. " +
+					"`${resource.name}-${####}`), default is empty to use the custom naming " +
+					"(see `aria_custom_naming`)",
+				Computed: true,
+				Optional: true,
+				Default:  stringdefault.StaticString(""),
+			},
 			"properties": schema.MapAttribute{
 				MarkdownDescription: "Custom properties to attach to project's resources",
 				ElementType:         types.StringType,
@@ -50,5 +95,38 @@ func ProjectSchema() schema.Schema {
 			*/
 			"org_id": ComputedOrganizationIdSchema(),
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": TimeoutsSchema(ctx),
+		},
+	}
+}
+
+func ProjectDataSourceSchema() dataschema.Schema {
+	return dataschema.Schema{
+		MarkdownDescription: "Project data source, lookup by identifier or name",
+		Attributes: map[string]dataschema.Attribute{
+			"id": OptionalIdentifierSchema(""),
+			"name": dataschema.StringAttribute{
+				MarkdownDescription: "Project name (must match exactly one project)",
+				Computed:            true,
+				Optional:            true,
+			},
+			"operation_timeout": dataschema.Int32Attribute{
+				MarkdownDescription: "Timeout (in seconds) that should be used for " +
+					"Cloud Template operations and Provisioning tasks",
+				Computed: true,
+			},
+			"shared_resources": dataschema.BoolAttribute{
+				MarkdownDescription: "Specifies whetever the resources are shared between " +
+					"project's members or not",
+				Computed: true,
+			},
+			"properties": dataschema.MapAttribute{
+				MarkdownDescription: "Custom properties attached to project's resources",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"org_id": ComputedOrganizationIdSchema(),
+		},
 	}
 }
@@ -0,0 +1,72 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProjectZoneModel describes the resource data model.
+type ProjectZoneModel struct {
+	ZoneId         types.String `tfsdk:"zone_id"`
+	Priority       types.Int32  `tfsdk:"priority"`
+	MaxInstances   types.Int32  `tfsdk:"max_instances"`
+	CPULimit       types.Int32  `tfsdk:"cpu_limit"`
+	MemoryLimitMB  types.Int64  `tfsdk:"memory_limit_mb"`
+	StorageLimitGB types.Int64  `tfsdk:"storage_limit_gb"`
+}
+
+// ProjectZoneAPIModel describes the resource API model.
+type ProjectZoneAPIModel struct {
+	ZoneId         string `json:"zoneId"`
+	Priority       int32  `json:"priority"`
+	MaxInstances   int32  `json:"maxNumberInstances"`
+	CPULimit       int32  `json:"cpuLimit"`
+	MemoryLimitMB  int64  `json:"memoryLimitMB"`
+	StorageLimitGB int64  `json:"storageLimitGB"`
+}
+
+func (self ProjectZoneModel) String() string {
+	return fmt.Sprintf(
+		"Project Zone %s (priority %d)",
+		self.ZoneId.ValueString(),
+		self.Priority.ValueInt32())
+}
+
+func (self *ProjectZoneModel) FromAPI(raw ProjectZoneAPIModel) {
+	self.ZoneId = types.StringValue(raw.ZoneId)
+	self.Priority = types.Int32Value(raw.Priority)
+	self.MaxInstances = types.Int32Value(raw.MaxInstances)
+	self.CPULimit = types.Int32Value(raw.CPULimit)
+	self.MemoryLimitMB = types.Int64Value(raw.MemoryLimitMB)
+	self.StorageLimitGB = types.Int64Value(raw.StorageLimitGB)
+}
+
+func (self ProjectZoneModel) ToAPI() ProjectZoneAPIModel {
+	return ProjectZoneAPIModel{
+		ZoneId:         self.ZoneId.ValueString(),
+		Priority:       self.Priority.ValueInt32(),
+		MaxInstances:   self.MaxInstances.ValueInt32(),
+		CPULimit:       self.CPULimit.ValueInt32(),
+		MemoryLimitMB:  self.MemoryLimitMB.ValueInt64(),
+		StorageLimitGB: self.StorageLimitGB.ValueInt64(),
+	}
+}
+
+// Utils -------------------------------------------------------------------------------------------
+
+// Used to convert structure to a types.Object.
+func (self ProjectZoneModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"zone_id":          types.StringType,
+		"priority":         types.Int32Type,
+		"max_instances":    types.Int32Type,
+		"cpu_limit":        types.Int32Type,
+		"memory_limit_mb":  types.Int64Type,
+		"storage_limit_gb": types.Int64Type,
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// A zone assigned to a project (limits are unlimited if 0).
+func ProjectZoneSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"zone_id": RequiredIdentifierSchema("Cloud zone identifier"),
+			"priority": schema.Int32Attribute{
+				MarkdownDescription: "Priority of the zone for provisioning resources " +
+					"(lower value means higher priority, default is 0)",
+				Computed: true,
+				Optional: true,
+				Default:  int32default.StaticInt32(0),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"max_instances": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of instances that can be provisioned in " +
+					"the zone (default is 0 for unlimited)",
+				Computed: true,
+				Optional: true,
+				Default:  int32default.StaticInt32(0),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"cpu_limit": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of CPUs that can be allocated in the zone " +
+					"(default is 0 for unlimited)",
+				Computed: true,
+				Optional: true,
+				Default:  int32default.StaticInt32(0),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"memory_limit_mb": schema.Int64Attribute{
+				MarkdownDescription: "Maximum memory (in MB) that can be allocated in the zone " +
+					"(default is 0 for unlimited)",
+				Computed: true,
+				Optional: true,
+				Default:  int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"storage_limit_gb": schema.Int64Attribute{
+				MarkdownDescription: "Maximum storage (in GB) that can be allocated in the zone " +
+					"(default is 0 for unlimited)",
+				Computed: true,
+				Optional: true,
+				Default:  int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+variable "test_project_ids" {
+  description = "Projects expected to be returned by the data source."
+  type        = string
+}
+
+locals {
+  project_ids = split(",", var.test_project_ids)
+}
+
+data "aria_projects" "all" {
+  lifecycle {
+    postcondition {
+      condition     = length(setsubtract(local.project_ids, self.projects[*].id)) == 0
+      error_message = "Projects must include ${var.test_project_ids}"
+    }
+  }
+}
+
+data "aria_projects" "filtered" {
+  filter = "id eq '${local.project_ids[0]}'"
+
+  lifecycle {
+    postcondition {
+      condition     = join(",", self.projects[*].id) == local.project_ids[0]
+      error_message = "Projects must be [${local.project_ids[0]}]"
+    }
+  }
+}
+
+data "aria_projects" "searched" {
+  search = "NO-PROJECT-IS-MATCHING-THIS-SEARCH"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aria_projects.all", "projects.0.id"),
+					resource.TestCheckResourceAttrSet("data.aria_projects.all", "projects.0.name"),
+					resource.TestCheckResourceAttrSet("data.aria_projects.all", "projects.0.org_id"),
+					resource.TestCheckResourceAttr("data.aria_projects.filtered", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.aria_projects.searched", "projects.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProjectsModel describes the data source data model.
+type ProjectsModel struct {
+	Filter types.String `tfsdk:"filter"`
+	Search types.String `tfsdk:"search"`
+
+	Projects []ProjectSummaryModel `tfsdk:"projects"`
+}
+
+// ProjectSummaryModel describes the projects returned by the data source.
+type ProjectSummaryModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	OperationTimeout types.Int32  `tfsdk:"operation_timeout"`
+	SharedResources  types.Bool   `tfsdk:"shared_resources"`
+	OrgId            types.String `tfsdk:"org_id"`
+}
+
+func (self ProjectsModel) String() string {
+	return fmt.Sprintf(
+		"Projects (filter %q, search %q)",
+		self.Filter.ValueString(),
+		self.Search.ValueString())
+}
+
+func (self ProjectsModel) ListQuery() ListQuery {
+	return ListQuery{
+		Path:       ProjectModel{}.ListPath(),
+		Pagination: LIST_PAGINATION_PAGE,
+		Filter:     self.Filter.ValueString(),
+	}
+}
+
+func (self *ProjectsModel) FromAPI(raws []ProjectAPIModel) {
+	self.Projects = []ProjectSummaryModel{}
+	for _, raw := range raws {
+		if MatchSearch(self.Search.ValueString(), raw.Name) {
+			project := ProjectSummaryModel{}
+			project.FromAPI(raw)
+			self.Projects = append(self.Projects, project)
+		}
+	}
+}
+
+func (self *ProjectSummaryModel) FromAPI(raw ProjectAPIModel) {
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.OperationTimeout = types.Int32Value(raw.OperationTimeout)
+	self.SharedResources = types.BoolValue(raw.SharedResources)
+	self.OrgId = types.StringValue(raw.OrgId)
+}
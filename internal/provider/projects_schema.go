@@ -0,0 +1,42 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func ProjectsDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Projects data source (all pages)",
+		Attributes: map[string]schema.Attribute{
+			"filter": ListFilterSchema(),
+			"search": ListSearchSchema(),
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "Projects",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": ComputedIdentifierSchema(""),
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Project name",
+							Computed:            true,
+						},
+						"operation_timeout": schema.Int32Attribute{
+							MarkdownDescription: "Timeout (in seconds) that should be used for " +
+								"Cloud Template operations and Provisioning tasks",
+							Computed: true,
+						},
+						"shared_resources": schema.BoolAttribute{
+							MarkdownDescription: "Specifies whetever the resources are shared " +
+								"between project's members or not",
+							Computed: true,
+						},
+						"org_id": ComputedOrganizationIdSchema(),
+					},
+				},
+			},
+		},
+	}
+}
@@ -69,7 +69,7 @@ func (self *PropertyGroupResource) Create(
 
 	var propertyGroupFromAPI PropertyGroupAPIModel
 	path := propertyGroup.CreatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetBody(propertyGroupToAPI).
 		SetResult(&propertyGroupFromAPI).
 		Post(path)
@@ -100,7 +100,7 @@ func (self *PropertyGroupResource) Read(
 	}
 
 	var propertyGroupFromAPI PropertyGroupAPIModel
-	found, _, readDiags := self.client.ReadIt(&propertyGroup, &propertyGroupFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &propertyGroup, &propertyGroupFromAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -136,7 +136,7 @@ func (self *PropertyGroupResource) Update(
 
 	var propertyGroupFromAPI PropertyGroupAPIModel
 	path := propertyGroup.UpdatePath()
-	response, err := self.client.R(path).
+	response, err := self.client.R(ctx, path).
 		SetBody(propertyGroupToAPI).
 		SetResult(&propertyGroupFromAPI).
 		Put(path)
@@ -166,7 +166,7 @@ func (self *PropertyGroupResource) Delete(
 		return
 	}
 
-	resp.Diagnostics.Append(self.client.DeleteIt(&propertyGroup)...)
+	resp.Diagnostics.Append(self.client.DeleteIt(ctx, &propertyGroup)...)
 }
 
 func (self *PropertyGroupResource) ImportState(
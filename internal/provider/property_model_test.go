@@ -93,6 +93,15 @@ func TestPropertyModel_Default_FromAPI(t *testing.T) {
 			propertyRaw:      nil,
 			propertyInternal: jsontypes.NewNormalizedNull(),
 		},
+		{
+			name:         "object value (YAML mapping)",
+			propertyType: "object",
+			propertyRaw: map[any]any{
+				"size":  int(10),
+				"disks": []any{map[any]any{"lunId": int(1)}},
+			},
+			propertyInternal: jsontypes.NewNormalizedValue(`{"disks":[{"lunId":1}],"size":10}`),
+		},
 	}
 
 	for _, tc := range cases {
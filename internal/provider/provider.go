@@ -5,11 +5,18 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -33,12 +40,46 @@ type AriaProvider struct {
 
 // AriaProviderModel describes the provider data model.
 type AriaProviderModel struct {
-	Host               types.String `tfsdk:"host"`
-	Insecure           types.Bool   `tfsdk:"insecure"`
-	RefreshToken       types.String `tfsdk:"refresh_token"`
-	AccessToken        types.String `tfsdk:"access_token"`
-	OKAPICallsLogLevel types.String `tfsdk:"ok_api_calls_log_level"`
-	KOAPICallsLogLevel types.String `tfsdk:"ko_api_calls_log_level"`
+	Host               types.String           `tfsdk:"host"`
+	Insecure           types.Bool             `tfsdk:"insecure"`
+	CACertFile         types.String           `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String           `tfsdk:"ca_cert_pem"`
+	ClientCertFile     types.String           `tfsdk:"client_cert_file"`
+	ClientCertPEM      types.String           `tfsdk:"client_cert_pem"`
+	ClientKeyFile      types.String           `tfsdk:"client_key_file"`
+	ClientKeyPEM       types.String           `tfsdk:"client_key_pem"`
+	RefreshToken       types.String           `tfsdk:"refresh_token"`
+	AccessToken        types.String           `tfsdk:"access_token"`
+	OKAPICallsLogLevel types.String           `tfsdk:"ok_api_calls_log_level"`
+	KOAPICallsLogLevel types.String           `tfsdk:"ko_api_calls_log_level"`
+	APICallsLogMaxBody types.Int64            `tfsdk:"api_calls_log_max_body_size"`
+	APICallsLogFile    types.String           `tfsdk:"api_calls_log_file"`
+	MaxRetries         types.Int64            `tfsdk:"max_retries"`
+	MinBackoff         types.String           `tfsdk:"min_backoff"`
+	MaxBackoff         types.String           `tfsdk:"max_backoff"`
+	ProxyURL           types.String           `tfsdk:"proxy_url"`
+	RequestTimeout     types.String           `tfsdk:"request_timeout"`
+	Headers            types.Map              `tfsdk:"headers"`
+	MaxConcurrent      types.Int64            `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond  types.Float64          `tfsdk:"requests_per_second"`
+	APILimits          types.Map              `tfsdk:"api_limits"`
+	Auth               *AriaProviderAuthModel `tfsdk:"auth"`
+}
+
+// AriaProviderAPILimitsModel describes the limits data model (of a specific API).
+type AriaProviderAPILimitsModel struct {
+	MaxConcurrent     types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+}
+
+// AriaProviderAuthModel describes the authentication data model.
+type AriaProviderAuthModel struct {
+	Mode         types.String `tfsdk:"mode"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	Domain       types.String `tfsdk:"domain"`
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
 }
 
 func (self *AriaProvider) Metadata(
@@ -79,6 +120,52 @@ func (self *AriaProvider) Schema(
 					"TLS certificate. May also be provided via ARIA_INSECURE environment variable.",
 				Optional: true,
 			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file of PEM encoded CA certificates to trust " +
+					"(in addition to the system ones) when verifying the TLS certificate. " +
+					"May also be provided via ARIA_CA_CERT_FILE environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates to trust (in addition to the " +
+					"system ones) when verifying the TLS certificate. " +
+					"May also be provided via ARIA_CA_CERT environment variable.",
+				Optional: true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file of PEM encoded client certificate for " +
+					"mutual TLS authentication (requires the client key). " +
+					"May also be provided via ARIA_CLIENT_CERT_FILE environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS " +
+					"authentication (requires the client key). " +
+					"May also be provided via ARIA_CLIENT_CERT environment variable.",
+				Optional: true,
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file of PEM encoded client private key for " +
+					"mutual TLS authentication (requires the client certificate). " +
+					"May also be provided via ARIA_CLIENT_KEY_FILE environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client private key for mutual TLS " +
+					"authentication (requires the client certificate). " +
+					"May also be provided via ARIA_CLIENT_KEY environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
 			"ok_api_calls_log_level": schema.StringAttribute{
 				MarkdownDescription: "Successful API calls log level. " +
 					"One of `INFO`, `DEBUG` or `TRACE` (default). " +
@@ -97,6 +184,163 @@ func (self *AriaProvider) Schema(
 					stringvalidator.OneOf([]string{"ERROR", "WARN", "DEBUG", "TRACE"}...),
 				},
 			},
+			"api_calls_log_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file where API calls are appended as JSON Lines " +
+					"(request ID, method, path, status, duration, attempt, redacted bodies, ...). " +
+					"Disabled by default. " +
+					"May also be provided via ARIA_API_CALLS_LOG_FILE environment variable.",
+				Optional: true,
+			},
+			"api_calls_log_max_body_size": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size (in bytes) of the request and response bodies " +
+					"in API calls logs, bigger bodies are truncated. Sensitive fields (tokens, " +
+					"passwords, sensitive attributes of the resources) are always redacted. " +
+					"Default is 0 (unlimited). " +
+					"May also be provided via ARIA_API_CALLS_LOG_MAX_BODY_SIZE environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries of an API call failing with a " +
+					"transient error (status 429, 502, 503, 504 or connection reset). " +
+					"Gateway errors (502, 504) and connection resets are only retried for " +
+					"idempotent methods (not POST and PATCH). " +
+					"Default is 3, set to 0 to disable retries. " +
+					"May also be provided via ARIA_MAX_RETRIES environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_backoff": schema.StringAttribute{
+				MarkdownDescription: "Minimum time to wait before retrying an API call " +
+					"(e.g. `500ms`, `1s`). Time is doubled (with jitter) on every retry, unless " +
+					"the API requests a specific delay (Retry-After header). Default is `1s`. " +
+					"May also be provided via ARIA_MIN_BACKOFF environment variable.",
+				Optional: true,
+			},
+			"max_backoff": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait before retrying an API call " +
+					"(e.g. `30s`, `2m`), including the delay requested by the API (Retry-After " +
+					"header). Default is `30s`. " +
+					"May also be provided via ARIA_MAX_BACKOFF environment variable.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the proxy to send API requests through " +
+					"(e.g. `http://proxy.your-company.net:3128`). Standard environment variables " +
+					"(HTTPS_PROXY, NO_PROXY, ...) are used if unset. " +
+					"May also be provided via ARIA_PROXY_URL environment variable.",
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time an API call may take, including reading the " +
+					"response (e.g. `30s`, `5m`). Default is `2m`, set to `0s` to disable. " +
+					"May also be provided via ARIA_REQUEST_TIMEOUT environment variable.",
+				Optional: true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Additional headers to send with every API request " +
+					"(their values are redacted from the logs).",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of concurrent API calls, " +
+					"to protect the appliance without lowering Terraform's parallelism. " +
+					"Default is 0 (unlimited). " +
+					"May also be provided via ARIA_MAX_CONCURRENT_REQUESTS environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum rate of API calls (e.g. `0.5`, `10`). " +
+					"Default is 0 (unlimited). " +
+					"May also be provided via ARIA_REQUESTS_PER_SECOND environment variable.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"api_limits": schema.MapNestedAttribute{
+				MarkdownDescription: "Limits of API calls per API (in addition to the global " +
+					"limits), key is the first element of the path (e.g. `vco`, `catalog`), " +
+					"one of `" + strings.Join(LIMITED_APIS, "`, `") + "`.",
+				Optional: true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(LIMITED_APIS...)),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"max_concurrent_requests": schema.Int64Attribute{
+							MarkdownDescription: "Maximum number of concurrent API calls, " +
+								"0 means unlimited.",
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"requests_per_second": schema.Float64Attribute{
+							MarkdownDescription: "Maximum rate of API calls, 0 means unlimited.",
+							Optional:            true,
+							Validators: []validator.Float64{
+								float64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"auth": schema.SingleNestedBlock{
+				MarkdownDescription: "Authentication settings, the refresh token (or access " +
+					"token) is used if unset.",
+				Attributes: map[string]schema.Attribute{
+					"mode": schema.StringAttribute{
+						MarkdownDescription: "Authentication mode, one of `refresh_token` " +
+							"(default), `password` (CSP/vIDM login with username, password and " +
+							"domain) or `client_credentials` (OAuth client credentials grant, " +
+							"for service accounts). " +
+							"May also be provided via ARIA_AUTH_MODE environment variable.",
+						Optional: true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(AUTH_MODES...),
+						},
+					},
+					"username": schema.StringAttribute{
+						MarkdownDescription: "The username to login with (password mode). " +
+							"May also be provided via ARIA_USERNAME environment variable.",
+						Optional: true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "The password to login with (password mode). " +
+							"May also be provided via ARIA_PASSWORD environment variable.",
+						Optional:  true,
+						Sensitive: true,
+					},
+					"domain": schema.StringAttribute{
+						MarkdownDescription: "The domain of the user (password mode), e.g. " +
+							"`System Domain` or your identity source. " +
+							"May also be provided via ARIA_DOMAIN environment variable.",
+						Optional: true,
+					},
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "The OAuth client ID (client credentials mode). " +
+							"May also be provided via ARIA_CLIENT_ID environment variable.",
+						Optional: true,
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "The OAuth client secret (client credentials mode). " +
+							"May also be provided via ARIA_CLIENT_SECRET environment variable.",
+						Optional:  true,
+						Sensitive: true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -172,6 +416,155 @@ func (self *AriaProvider) Configure(
 		)
 	}
 
+	if config.APICallsLogFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_calls_log_file"),
+			"Unknown API Calls Log File",
+			"Either set the log file in the provider configuration to a static value, "+
+				"apply the source of the value first, or use ARIA_API_CALLS_LOG_FILE.",
+		)
+	}
+
+	if config.APICallsLogMaxBody.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_calls_log_max_body_size"),
+			"Unknown API Calls Log Max Body Size",
+			"Either set the max body size in the provider configuration to a static value, "+
+				"apply the source of the value first, or use ARIA_API_CALLS_LOG_MAX_BODY_SIZE.",
+		)
+	}
+
+	if config.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown Max Retries",
+			"Either set the max retries in the provider configuration to a static value, "+
+				"apply the source of the value first, or use ARIA_MAX_RETRIES.",
+		)
+	}
+
+	if config.MinBackoff.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_backoff"),
+			"Unknown Min Backoff",
+			"Either set the min backoff in the provider configuration to a static value, "+
+				"apply the source of the value first, or use ARIA_MIN_BACKOFF.",
+		)
+	}
+
+	if config.MaxBackoff.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_backoff"),
+			"Unknown Max Backoff",
+			"Either set the max backoff in the provider configuration to a static value, "+
+				"apply the source of the value first, or use ARIA_MAX_BACKOFF.",
+		)
+	}
+
+	if config.ProxyURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proxy_url"),
+			"Unknown Proxy URL",
+			"Either set the proxy URL in the provider configuration to a static value, "+
+				"apply the source of the value first, or use ARIA_PROXY_URL.",
+		)
+	}
+
+	if config.RequestTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Unknown Request Timeout",
+			"Either set the request timeout in the provider configuration to a static value, "+
+				"apply the source of the value first, or use ARIA_REQUEST_TIMEOUT.",
+		)
+	}
+
+	if config.Headers.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("headers"),
+			"Unknown Headers",
+			"Either set the headers in the provider configuration to static values or "+
+				"apply the source of the values first.",
+		)
+	}
+
+	if config.MaxConcurrent.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Unknown Max Concurrent Requests",
+			"Either set the max concurrent requests in the provider configuration to a static "+
+				"value, apply the source of the value first, or use ARIA_MAX_CONCURRENT_REQUESTS.",
+		)
+	}
+
+	if config.RequestsPerSecond.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Unknown Requests Per Second",
+			"Either set the requests per second in the provider configuration to a static value, "+
+				"apply the source of the value first, or use ARIA_REQUESTS_PER_SECOND.",
+		)
+	}
+
+	if config.APILimits.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_limits"),
+			"Unknown API Limits",
+			"Either set the API limits in the provider configuration to static values or "+
+				"apply the source of the values first.",
+		)
+	}
+
+	for name, attribute := range map[string]types.String{
+		"ca_cert_file":     config.CACertFile,
+		"ca_cert_pem":      config.CACertPEM,
+		"client_cert_file": config.ClientCertFile,
+		"client_cert_pem":  config.ClientCertPEM,
+		"client_key_file":  config.ClientKeyFile,
+		"client_key_pem":   config.ClientKeyPEM,
+	} {
+		if attribute.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unknown Aria API TLS Setting",
+				fmt.Sprintf(
+					"Either set the %s in the provider configuration to a static value, "+
+						"apply the source of the value first, or use %s.",
+					strings.ReplaceAll(name, "_", " "),
+					"ARIA_"+strings.ToUpper(strings.TrimSuffix(name, "_pem"))),
+			)
+		}
+	}
+
+	auth := config.Auth
+	if auth == nil {
+		auth = &AriaProviderAuthModel{}
+	}
+
+	for name, attribute := range map[string]types.String{
+		"mode":          auth.Mode,
+		"username":      auth.Username,
+		"password":      auth.Password,
+		"domain":        auth.Domain,
+		"client_id":     auth.ClientId,
+		"client_secret": auth.ClientSecret,
+	} {
+		if attribute.IsUnknown() {
+			envName := "ARIA_" + strings.ToUpper(name)
+			if name == "mode" {
+				envName = "ARIA_AUTH_MODE"
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth").AtName(name),
+				"Unknown Aria API Authentication Setting",
+				fmt.Sprintf(
+					"Either set the %s in the provider configuration to a static value, "+
+						"apply the source of the value first, or use %s.",
+					strings.ReplaceAll(name, "_", " "), envName),
+			)
+		}
+	}
+
 	// Retrieve default values from environment variables if set
 
 	host := os.Getenv("ARIA_HOST")
@@ -206,6 +599,29 @@ func (self *AriaProvider) Configure(
 		}
 	}
 
+	caCert, diags := GetPEMSetting(
+		config.CACertFile, config.CACertPEM, "ca_cert_file", "ARIA_CA_CERT_FILE", "ARIA_CA_CERT")
+	resp.Diagnostics.Append(diags...)
+
+	clientCert, diags := GetPEMSetting(
+		config.ClientCertFile, config.ClientCertPEM,
+		"client_cert_file", "ARIA_CLIENT_CERT_FILE", "ARIA_CLIENT_CERT")
+	resp.Diagnostics.Append(diags...)
+
+	clientKey, diags := GetPEMSetting(
+		config.ClientKeyFile, config.ClientKeyPEM,
+		"client_key_file", "ARIA_CLIENT_KEY_FILE", "ARIA_CLIENT_KEY")
+	resp.Diagnostics.Append(diags...)
+
+	if (len(clientCert) == 0) != (len(clientKey) == 0) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_cert_file"),
+			"Incomplete Aria API Client Certificate",
+			"Set both the client certificate and key (file or PEM) in the provider configuration "+
+				"or use ARIA_CLIENT_{CERT,KEY}[_FILE] and ensure they are not empty.",
+		)
+	}
+
 	refresh_token := os.Getenv("ARIA_REFRESH_TOKEN")
 	if !config.RefreshToken.IsNull() {
 		refresh_token = config.RefreshToken.ValueString()
@@ -216,12 +632,51 @@ func (self *AriaProvider) Configure(
 		access_token = config.AccessToken.ValueString()
 	}
 
-	if len(refresh_token) == 0 && len(access_token) == 0 {
+	authMode := GetStringSetting(auth.Mode, "ARIA_AUTH_MODE")
+	if len(authMode) == 0 {
+		authMode = AUTH_MODE_REFRESH_TOKEN
+	}
+	username := GetStringSetting(auth.Username, "ARIA_USERNAME")
+	password := GetStringSetting(auth.Password, "ARIA_PASSWORD")
+	domain := GetStringSetting(auth.Domain, "ARIA_DOMAIN")
+	clientId := GetStringSetting(auth.ClientId, "ARIA_CLIENT_ID")
+	clientSecret := GetStringSetting(auth.ClientSecret, "ARIA_CLIENT_SECRET")
+
+	switch authMode {
+	case AUTH_MODE_REFRESH_TOKEN:
+		if len(refresh_token) == 0 && len(access_token) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("refresh_token"),
+				"Missing Aria API Token",
+				"Set either the refresh or access token in the provider configuration "+
+					"or use one of ARIA_{ACCESS,REFRESH}_TOKEN and ensure its not empty.",
+			)
+		}
+	case AUTH_MODE_PASSWORD:
+		if len(username) == 0 || len(password) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth").AtName("username"),
+				"Missing Aria API Credentials",
+				"Set both the username and password in the auth block of the provider "+
+					"configuration or use ARIA_{USERNAME,PASSWORD} and ensure they are not empty.",
+			)
+		}
+	case AUTH_MODE_CLIENT_CREDENTIALS:
+		if len(clientId) == 0 || len(clientSecret) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth").AtName("client_id"),
+				"Missing Aria API Client Credentials",
+				"Set both the client ID and secret in the auth block of the provider "+
+					"configuration or use ARIA_CLIENT_{ID,SECRET} and ensure they are not empty.",
+			)
+		}
+	default:
 		resp.Diagnostics.AddAttributeError(
-			path.Root("refresh_token"),
-			"Missing Aria API Token",
-			"Set either the refresh or access token in the provider configuration "+
-				"or use one of ARIA_{ACCESS,REFRESH}_TOKEN and ensure its not empty.",
+			path.Root("auth").AtName("mode"),
+			"Invalid Aria API Authentication Mode",
+			fmt.Sprintf(
+				"Environment variable ARIA_AUTH_MODE must be one of %s.",
+				strings.Join(AUTH_MODES, ", ")),
 		)
 	}
 
@@ -239,22 +694,156 @@ func (self *AriaProvider) Configure(
 		koLogLevel = "ERROR"
 	}
 
+	apiCallsLogFile := GetStringSetting(config.APICallsLogFile, "ARIA_API_CALLS_LOG_FILE")
+
+	var maxBodySize int64
+	if !config.APICallsLogMaxBody.IsNull() {
+		maxBodySize = config.APICallsLogMaxBody.ValueInt64()
+	} else if raw := os.Getenv("ARIA_API_CALLS_LOG_MAX_BODY_SIZE"); len(raw) > 0 {
+		var err error
+		maxBodySize, err = strconv.ParseInt(raw, 10, 64)
+		if err != nil || maxBodySize < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_calls_log_max_body_size"),
+				"Invalid API Calls Log Max Body Size",
+				"Environment variable ARIA_API_CALLS_LOG_MAX_BODY_SIZE is not a valid positive "+
+					"integer.",
+			)
+		}
+	}
+
+	maxRetries := int64(DEFAULT_MAX_RETRIES)
+	if !config.MaxRetries.IsNull() {
+		maxRetries = config.MaxRetries.ValueInt64()
+	} else if maxRetriesRaw := os.Getenv("ARIA_MAX_RETRIES"); len(maxRetriesRaw) > 0 {
+		var err error
+		maxRetries, err = strconv.ParseInt(maxRetriesRaw, 10, 32)
+		if err != nil || maxRetries < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Max Retries",
+				"Environment variable ARIA_MAX_RETRIES is not a valid positive integer.",
+			)
+		}
+	}
+
+	minBackoff, diags := GetDurationSetting(
+		config.MinBackoff, "min_backoff", "ARIA_MIN_BACKOFF", DEFAULT_MIN_BACKOFF)
+	resp.Diagnostics.Append(diags...)
+
+	maxBackoff, diags := GetDurationSetting(
+		config.MaxBackoff, "max_backoff", "ARIA_MAX_BACKOFF", DEFAULT_MAX_BACKOFF)
+	resp.Diagnostics.Append(diags...)
+
+	proxyURL := GetStringSetting(config.ProxyURL, "ARIA_PROXY_URL")
+
+	requestTimeout, diags := GetDurationSetting(
+		config.RequestTimeout, "request_timeout", "ARIA_REQUEST_TIMEOUT", DEFAULT_REQUEST_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+
+	headers := map[string]string{}
+	if !config.Headers.IsNull() && !config.Headers.IsUnknown() {
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &headers, false)...)
+	}
+
+	limits := APILimits{}
+	if !config.MaxConcurrent.IsNull() {
+		limits.MaxConcurrentRequests = int(config.MaxConcurrent.ValueInt64())
+	} else if raw := os.Getenv("ARIA_MAX_CONCURRENT_REQUESTS"); len(raw) > 0 {
+		value, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil || value < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_concurrent_requests"),
+				"Invalid Max Concurrent Requests",
+				"Environment variable ARIA_MAX_CONCURRENT_REQUESTS is not a valid positive integer.",
+			)
+		}
+		limits.MaxConcurrentRequests = int(value)
+	}
+
+	if !config.RequestsPerSecond.IsNull() {
+		limits.RequestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+	} else if raw := os.Getenv("ARIA_REQUESTS_PER_SECOND"); len(raw) > 0 {
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil || value < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("requests_per_second"),
+				"Invalid Requests Per Second",
+				"Environment variable ARIA_REQUESTS_PER_SECOND is not a valid positive number.",
+			)
+		}
+		limits.RequestsPerSecond = value
+	}
+
+	apiLimits := map[string]APILimits{}
+	if !config.APILimits.IsNull() && !config.APILimits.IsUnknown() {
+		apiLimitsConfig := map[string]AriaProviderAPILimitsModel{}
+		resp.Diagnostics.Append(config.APILimits.ElementsAs(ctx, &apiLimitsConfig, false)...)
+		for api, apiLimitsItem := range apiLimitsConfig {
+			apiLimits[api] = APILimits{
+				MaxConcurrentRequests: int(apiLimitsItem.MaxConcurrent.ValueInt64()),
+				RequestsPerSecond:     apiLimitsItem.RequestsPerSecond.ValueFloat64(),
+			}
+		}
+	}
+
+	if minBackoff > maxBackoff {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_backoff"),
+			"Invalid Min Backoff",
+			fmt.Sprintf("Min backoff (%s) must be lower or equal to max backoff (%s).",
+				minBackoff, maxBackoff),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ctx = tflog.SetField(ctx, "aria_host", host)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "aria_refresh_token", refresh_token)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "aria_access_token", access_token)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "aria_password", password)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "aria_client_secret", clientSecret)
+	ctx = tflog.SetField(ctx, "aria_auth_mode", authMode)
 	ctx = tflog.SetField(ctx, "aria_insecure", insecure)
+	ctx = tflog.SetField(ctx, "aria_proxy_url", proxyURL)
 
 	tflog.Debug(ctx, "Creating Aria client")
 
 	// Create a new Aria client using the configuration values
 	client := AriaClient{
 		Host:               host,
+		AuthMode:           authMode,
 		RefreshToken:       refresh_token,
 		AccessToken:        access_token,
+		Username:           username,
+		Password:           password,
+		Domain:             domain,
+		ClientId:           clientId,
+		ClientSecret:       clientSecret,
 		Insecure:           insecure,
+		CACertPEM:          caCert,
+		ClientCertPEM:      clientCert,
+		ClientKeyPEM:       clientKey,
 		Context:            ctx,
 		OKAPICallsLogLevel: okLogLevel,
 		KOAPICallsLogLevel: koLogLevel,
+		SensitiveFields:    GetSensitiveFields(ctx, self.Resources(ctx)),
+		MaxLoggedBodySize:  int(maxBodySize),
+		APICallsLogFile:    apiCallsLogFile,
+		MaxRetries:         int(maxRetries),
+		MinBackoff:         minBackoff,
+		MaxBackoff:         maxBackoff,
+		UserAgent:          "terraform-provider-aria/" + self.version,
+		ProxyURL:           proxyURL,
+		RequestTimeout:     requestTimeout,
+		Headers:            headers,
+		Limits:             limits,
+		APILimits:          apiLimits,
+		// Recording and replaying API calls is a testing facility (see README)
+		CassetteMode: os.Getenv("ARIA_CASSETTE_MODE"),
+		CassetteFile: os.Getenv("ARIA_CASSETTE_FILE"),
 	}
 
 	clientDiags := client.Init()
@@ -277,11 +866,17 @@ func (self *AriaProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewABXSensitiveConstantResource,
 		NewCatalogItemIconResource,
 		NewCatalogSourceResource,
+		NewCloudAccountVSphereResource,
 		NewCloudTemplateV1Resource,
+		NewCloudTemplateVersionResource,
+		NewCloudZoneResource,
 		NewCustomFormResource,
 		NewCustomNamingResource,
 		NewCustomResourceResource,
+		NewDeploymentResource,
+		NewFlavorProfileResource,
 		NewIconResource,
+		NewImageProfileResource,
 		NewOrchestratorActionResource,
 		NewOrchestratorCategoryResource,
 		NewOrchestratorConfigurationResource,
@@ -291,6 +886,7 @@ func (self *AriaProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewOrchestratorWorkflowResource,
 		NewPolicyResource,
 		NewProjectResource,
+		NewProjectMembershipResource,
 		NewPropertyGroupResource,
 		NewResourceActionResource,
 		NewSubscriptionResource,
@@ -300,17 +896,112 @@ func (self *AriaProvider) Resources(ctx context.Context) []func() resource.Resou
 
 func (self *AriaProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewABXActionDataSource,
+		NewABXActionsDataSource,
 		NewCatalogItemDataSource,
+		NewCatalogItemsDataSource,
 		NewCatalogTypeDataSource,
+		NewCloudAccountVSphereDataSource,
+		NewCloudZoneDataSource,
 		NewIconDataSource,
 		NewIntegrationDataSource,
+		NewOrchestratorActionDataSource,
+		NewOrchestratorCategoryDataSource,
 		NewOrchestratorConfigurationDataSource,
+		NewOrchestratorWorkflowDataSource,
+		NewOrchestratorWorkflowsDataSource,
+		NewProjectDataSource,
+		NewProjectsDataSource,
 		NewSecretDataSource,
+		NewSecretsDataSource,
+		NewSubscriptionsDataSource,
+		NewTagsDataSource,
 	}
 }
 
 func (self *AriaProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewABXInputKeyFunction,
+		NewCloudTemplateYAMLFunction,
+		NewVROFQNFunction,
+	}
+}
+
+// Return string from configuration (or environment variable if unset).
+func GetStringSetting(value types.String, envName string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(envName)
+}
+
+// Return PEM content from configuration (or environment variables if unset), either directly or
+// read from a file. FileName is the attribute name of the file, for reporting errors.
+// Precedence is the PEM attribute, the file attribute, then the PEM and file environment variables.
+func GetPEMSetting(
+	fileValue types.String,
+	pemValue types.String,
+	fileName string,
+	fileEnvName string,
+	pemEnvName string,
+) (string, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	if pem := pemValue.ValueString(); len(pem) > 0 {
+		return pem, diags
+	}
+
+	file := fileValue.ValueString()
+	if len(file) == 0 {
+		if pem := os.Getenv(pemEnvName); len(pem) > 0 {
+			return pem, diags
+		}
+		file = os.Getenv(fileEnvName)
+	}
+	if len(file) == 0 {
+		return "", diags
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(fileName),
+			"Unreadable PEM File",
+			fmt.Sprintf("Unable to read %s, got error: %s", file, err),
+		)
+	}
+	return string(content), diags
+}
+
+// Return duration from configuration (or environment variable if unset), default value if both are
+// unset. Name is the attribute name, for reporting errors.
+func GetDurationSetting(
+	value types.String,
+	name string,
+	envName string,
+	defaultValue time.Duration,
+) (time.Duration, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	raw := os.Getenv(envName)
+	source := "Environment variable " + envName
+	if !value.IsNull() {
+		raw = value.ValueString()
+		source = "Attribute " + name
+	}
+	if len(raw) == 0 {
+		return defaultValue, diags
+	}
+
+	duration, err := time.ParseDuration(raw)
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(
+			path.Root(name),
+			"Invalid Duration",
+			fmt.Sprintf("%s is not a valid positive duration (e.g. 500ms, 10s, 2m).", source),
+		)
+	}
+	return duration, diags
 }
 
 func New(version string) func() provider.Provider {
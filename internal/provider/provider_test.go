@@ -4,10 +4,19 @@
 package provider
 
 import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/davidfischer-ch/terraform-provider-aria/internal/fakearia"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -18,8 +27,116 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"aria": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// Fake Aria API shared by the acceptance tests when ARIA_HOST is not set.
+var testAccFakeAria *fakearia.Server
+var testAccFakeAriaOnce sync.Once
+
 func testAccPreCheck(t *testing.T) {
-	// You can add code here to run prior to any test case execution, for example assertions
-	// about the appropriate environment variables being set are common to see in a pre-check
-	// function.
+	cassetteMode := os.Getenv("ARIA_CASSETTE_MODE")
+	if cassetteMode == CASSETTE_MODE_REPLAY {
+		testAccCassette(t, cassetteMode)
+		return
+	}
+
+	// Target the fake Aria API (and its seeded instances) unless a real instance is given.
+	testAccFakeAriaOnce.Do(func() {
+		if len(os.Getenv("ARIA_HOST")) > 0 {
+			return
+		}
+		testAccFakeAria = fakearia.NewServer()
+		env := map[string]string{
+			"ARIA_HOST":          testAccFakeAria.URL,
+			"ARIA_REFRESH_TOKEN": fakearia.REFRESH_TOKEN,
+		}
+		for name, value := range testAccFakeAria.Variables() {
+			env["TF_VAR_"+name] = value
+		}
+		for name, value := range env {
+			if err := os.Setenv(name, value); err != nil {
+				t.Fatalf("Unable to set %s, got error: %s", name, err)
+			}
+		}
+	})
+
+	if cassetteMode == CASSETTE_MODE_RECORD {
+		testAccCassette(t, cassetteMode)
+	}
+}
+
+// Record the API calls of the test into its cassette (testdata/cassettes/<test>.yaml) or replay
+// them (without any Aria instance), see ARIA_CASSETTE_MODE.
+func testAccCassette(t *testing.T, mode string) {
+	file := filepath.Join("testdata", "cassettes", t.Name()+".yaml")
+	if mode == CASSETTE_MODE_REPLAY {
+		if _, err := os.Stat(file); errors.Is(err, os.ErrNotExist) {
+			t.Skipf("No cassette recorded for %s", t.Name())
+		}
+	}
+
+	cassette, err := OpenCassette(file, mode)
+	if err != nil {
+		t.Fatalf("Unable to open cassette, got error: %s", err)
+	}
+	t.Cleanup(func() { CloseCassette(file) })
+	t.Setenv("ARIA_CASSETTE_FILE", file)
+
+	if mode == CASSETTE_MODE_REPLAY {
+		t.Setenv("ARIA_HOST", CASSETTE_HOST)
+		t.Setenv("ARIA_REFRESH_TOKEN", REDACTED)
+		for name, value := range cassette.Variables {
+			t.Setenv("TF_VAR_"+name, value)
+		}
+		return
+	}
+
+	// Variables are required to replay the test (e.g. identifiers of the seeded instances)
+	provider := New("test")().(*AriaProvider)
+	sensitiveFields := GetSensitiveFields(t.Context(), provider.Resources(t.Context()))
+	for _, variable := range os.Environ() {
+		name, value, _ := strings.Cut(variable, "=")
+		if name, found := strings.CutPrefix(name, "TF_VAR_"); found {
+			// Sensitive fields are redacted from the API calls, their value is not required
+			if sensitiveFields.IsSensitiveVariable(name) {
+				value = REDACTED
+			}
+			cassette.Variables[name] = value
+		}
+	}
+	if err := cassette.Save(); err != nil {
+		t.Fatalf("Unable to save cassette, got error: %s", err)
+	}
+}
+
+func TestGetPEMSetting(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(file, []byte("file-pem"), 0o600); err != nil {
+		t.Fatalf("Unable to write %s, got error: %s", file, err)
+	}
+
+	cases := []struct {
+		name     string
+		file     types.String
+		pem      types.String
+		fileEnv  string
+		pemEnv   string
+		expected string
+	}{
+		{"unset", types.StringNull(), types.StringNull(), "", "", ""},
+		{"pem", types.StringValue(file), types.StringValue("pem"), "", "env-pem", "pem"},
+		{"file over env pem", types.StringValue(file), types.StringNull(), "", "env-pem", "file-pem"},
+		{"env pem", types.StringNull(), types.StringNull(), file, "env-pem", "env-pem"},
+		{"env file", types.StringNull(), types.StringNull(), file, "", "file-pem"},
+	}
+
+	for index, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fileEnvName := fmt.Sprintf("ARIA_TEST_PEM_FILE_%d", index)
+			pemEnvName := fmt.Sprintf("ARIA_TEST_PEM_%d", index)
+			t.Setenv(fileEnvName, tc.fileEnv)
+			t.Setenv(pemEnvName, tc.pemEnv)
+			pem, diags := GetPEMSetting(tc.file, tc.pem, "ca_cert_file", fileEnvName, pemEnvName)
+			CheckDiagnostics(t, diags, "", "")
+			CheckEqual(t, pem, tc.expected)
+		})
+	}
 }
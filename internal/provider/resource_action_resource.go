@@ -64,6 +64,9 @@ func (self *ResourceActionResource) Create(
 		return
 	}
 
+	// Concurrent operations on the same instance must replay their own API calls
+	ctx = WithCassetteOperation(ctx, action.String())
+
 	self.client.Mutex.Lock(ctx, action.LockKey())
 	actionFromAPI, diags := self.ManageIt(ctx, &action, "create")
 	self.client.Mutex.Unlock(ctx, action.LockKey())
@@ -90,9 +93,11 @@ func (self *ResourceActionResource) Read(
 		return
 	}
 
+	ctx = WithCassetteOperation(ctx, action.String())
+
 	var actionFromAPI ResourceActionAPIModel
 	self.client.Mutex.RLock(ctx, action.LockKey())
-	found, _, diags := self.client.ReadIt(&action, &actionFromAPI)
+	found, _, diags := self.client.ReadIt(ctx, &action, &actionFromAPI)
 	self.client.Mutex.RUnlock(ctx, action.LockKey())
 	resp.Diagnostics.Append(diags...)
 	if !found {
@@ -121,6 +126,8 @@ func (self *ResourceActionResource) Update(
 		return
 	}
 
+	ctx = WithCassetteOperation(ctx, action.String())
+
 	self.client.Mutex.Lock(ctx, action.LockKey())
 	actionFromAPI, diags := self.ManageIt(ctx, &action, "update")
 	self.client.Mutex.Unlock(ctx, action.LockKey())
@@ -147,6 +154,8 @@ func (self *ResourceActionResource) Delete(
 		return
 	}
 
+	ctx = WithCassetteOperation(ctx, action.String())
+
 	self.client.Mutex.Lock(ctx, action.LockKey())
 	_, diags := self.ManageIt(ctx, &action, "delete")
 	self.client.Mutex.Unlock(ctx, action.LockKey())
@@ -188,7 +197,7 @@ func (self *ResourceActionResource) ManageIt(
 
 		// Retrieve the custom resource
 		tflog.Debug(ctx, fmt.Sprintf("Retrieve %s", resource.String()))
-		found, _, someDiags := self.client.ReadIt(&resource, &resourceRaw)
+		found, _, someDiags := self.client.ReadIt(ctx, &resource, &resourceRaw)
 		diags.Append(someDiags...)
 		diags.Append(resource.FromAPI(ctx, resourceRaw)...)
 
@@ -284,7 +293,7 @@ func (self *ResourceActionResource) ManageIt(
 
 		// Update the custom resource
 		path := resource.UpdatePath()
-		response, err := self.client.R(path).SetBody(resourceRaw).SetResult(&resourceRaw).Post(path)
+		response, err := self.client.R(ctx, path).SetBody(resourceRaw).SetResult(&resourceRaw).Post(path)
 		err = self.client.HandleAPIResponse(response, err, []int{200})
 		if err != nil {
 			diags.AddError(
@@ -334,7 +343,7 @@ func (self *ResourceActionResource) ManageIt(
 
 		/* Delete: Delete the resource action */
 		if method == "delete" {
-			diags.Append(self.client.DeleteIt(action)...)
+			diags.Append(self.client.DeleteIt(ctx, action)...)
 			return actionRaw, diags
 		}
 
@@ -346,7 +355,7 @@ func (self *ResourceActionResource) ManageIt(
 			path = action.UpdatePath()
 		}
 
-		response, err := self.client.R(path).SetBody(actionRaw).SetResult(&actionRaw).Post(path)
+		response, err := self.client.R(ctx, path).SetBody(actionRaw).SetResult(&actionRaw).Post(path)
 		err = self.client.HandleAPIResponse(response, err, []int{200})
 		if err != nil {
 			diags.AddError(
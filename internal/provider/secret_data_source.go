@@ -60,7 +60,7 @@ func (self *SecretDataSource) Read(
 
 	var secretFromAPI SecretAPIModel
 	path := secret.ReadPath()
-	response, err := self.client.R(path).SetResult(&secretFromAPI).Get(path)
+	response, err := self.client.R(ctx, path).SetResult(&secretFromAPI).Get(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -48,6 +48,10 @@ func (self SecretModel) String() string {
 		self.Name.ValueString())
 }
 
+func (self SecretModel) ListPath() string {
+	return "platform/api/secrets"
+}
+
 func (self SecretModel) ReadPath() string {
 	return "platform/api/secrets/" + self.Id.ValueString()
 }
@@ -0,0 +1,72 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SecretsDataSource{}
+
+func NewSecretsDataSource() datasource.DataSource {
+	return &SecretsDataSource{}
+}
+
+// SecretsDataSource defines the data source implementation.
+type SecretsDataSource struct {
+	client *AriaClient
+}
+
+func (self *SecretsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_secrets"
+}
+
+func (self *SecretsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = SecretsDataSourceSchema()
+}
+
+func (self *SecretsDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self *SecretsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var secrets SecretsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &secrets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretsFromAPI, err := ListAll[SecretAPIModel](ctx, self.client, secrets.ListQuery())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to list %s, got error: %s", secrets.String(), err))
+		return
+	}
+
+	// Save secrets into Terraform state
+	resp.Diagnostics.Append(secrets.FromAPI(ctx, secretsFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &secrets)...)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSecretsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+variable "test_secret_id" {
+  description = "Secret expected to be returned by the data source."
+  type        = string
+}
+
+data "aria_secret" "secret" {
+  id = var.test_secret_id
+}
+
+data "aria_secrets" "all" {
+  lifecycle {
+    postcondition {
+      condition     = contains(self.secrets[*].id, var.test_secret_id)
+      error_message = "Secrets must include ${var.test_secret_id}"
+    }
+  }
+}
+
+data "aria_secrets" "searched" {
+  search = upper(data.aria_secret.secret.name)
+
+  lifecycle {
+    postcondition {
+      condition     = contains(self.secrets[*].id, var.test_secret_id)
+      error_message = "Secrets must include ${var.test_secret_id}"
+    }
+  }
+}
+
+data "aria_secrets" "none" {
+  search = "NO-SECRET-IS-MATCHING-THIS-SEARCH"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aria_secrets.all", "secrets.0.id"),
+					resource.TestCheckResourceAttrSet("data.aria_secrets.all", "secrets.0.name"),
+					resource.TestCheckResourceAttrSet("data.aria_secrets.all", "secrets.0.org_id"),
+					resource.TestCheckResourceAttr("data.aria_secrets.none", "secrets.#", "0"),
+				),
+			},
+		},
+	})
+}
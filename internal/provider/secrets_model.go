@@ -0,0 +1,48 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SecretsModel describes the data source data model.
+type SecretsModel struct {
+	Filter types.String `tfsdk:"filter"`
+	Search types.String `tfsdk:"search"`
+
+	Secrets []SecretModel `tfsdk:"secrets"`
+}
+
+func (self SecretsModel) String() string {
+	return fmt.Sprintf(
+		"Secrets (filter %q, search %q)",
+		self.Filter.ValueString(),
+		self.Search.ValueString())
+}
+
+func (self SecretsModel) ListQuery() ListQuery {
+	return ListQuery{
+		Path:       SecretModel{}.ListPath(),
+		Pagination: LIST_PAGINATION_PAGE,
+		Filter:     self.Filter.ValueString(),
+	}
+}
+
+func (self *SecretsModel) FromAPI(ctx context.Context, raws []SecretAPIModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	self.Secrets = []SecretModel{}
+	for _, raw := range raws {
+		if MatchSearch(self.Search.ValueString(), raw.Name) {
+			secret := SecretModel{}
+			diags.Append(secret.FromAPI(ctx, raw)...)
+			self.Secrets = append(self.Secrets, secret)
+		}
+	}
+	return diags
+}
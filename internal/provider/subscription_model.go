@@ -82,6 +82,10 @@ func (self SubscriptionModel) LockKey() string {
 	return "subscription-" + self.Id.ValueString()
 }
 
+func (self SubscriptionModel) ListPath() string {
+	return "event-broker/api/subscriptions"
+}
+
 func (self SubscriptionModel) CreatePath() string {
 	return "event-broker/api/subscriptions"
 }
@@ -69,7 +69,7 @@ func (self *SubscriptionResource) Create(
 	}
 
 	path := subscription.CreatePath()
-	response, err := self.client.R(path).SetBody(subscriptionToAPI).Post(path)
+	response, err := self.client.R(ctx, path).SetBody(subscriptionToAPI).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{201})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -81,7 +81,7 @@ func (self *SubscriptionResource) Create(
 	// Read (using API) to retrieve the subscription content (and not empty stuff)
 	var subscriptionFromAPI SubscriptionAPIModel
 	path = subscription.ReadPath()
-	response, err = self.client.R(path).SetResult(&subscriptionFromAPI).Get(path)
+	response, err = self.client.R(ctx, path).SetResult(&subscriptionFromAPI).Get(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -109,7 +109,7 @@ func (self *SubscriptionResource) Read(
 	}
 
 	var subscriptionFromAPI SubscriptionAPIModel
-	found, _, readDiags := self.client.ReadIt(&subscription, &subscriptionFromAPI)
+	found, _, readDiags := self.client.ReadIt(ctx, &subscription, &subscriptionFromAPI)
 	resp.Diagnostics.Append(readDiags...)
 	if !found {
 		resp.State.RemoveResource(ctx)
@@ -144,7 +144,7 @@ func (self *SubscriptionResource) Update(
 	}
 
 	path := subscription.UpdatePath()
-	response, err := self.client.R(path).SetBody(subscriptionToAPI).Post(path)
+	response, err := self.client.R(ctx, path).SetBody(subscriptionToAPI).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{201})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -156,7 +156,7 @@ func (self *SubscriptionResource) Update(
 	// Read (using API) to retrieve the subscription content (and not empty stuff)
 	var subscriptionFromAPI SubscriptionAPIModel
 	path = subscription.ReadPath()
-	response, err = self.client.R(path).SetResult(&subscriptionFromAPI).Get(path)
+	response, err = self.client.R(ctx, path).SetResult(&subscriptionFromAPI).Get(path)
 	err = self.client.HandleAPIResponse(response, err, []int{200})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -180,7 +180,7 @@ func (self *SubscriptionResource) Delete(
 	var subscription SubscriptionModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &subscription)...)
 	if !resp.Diagnostics.HasError() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&subscription)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &subscription)...)
 	}
 }
 
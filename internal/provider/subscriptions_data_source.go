@@ -0,0 +1,73 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SubscriptionsDataSource{}
+
+func NewSubscriptionsDataSource() datasource.DataSource {
+	return &SubscriptionsDataSource{}
+}
+
+// SubscriptionsDataSource defines the data source implementation.
+type SubscriptionsDataSource struct {
+	client *AriaClient
+}
+
+func (self *SubscriptionsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_subscriptions"
+}
+
+func (self *SubscriptionsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = SubscriptionsDataSourceSchema()
+}
+
+func (self *SubscriptionsDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self *SubscriptionsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var subscriptions SubscriptionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &subscriptions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := subscriptions.ListQuery()
+	subscriptionsFromAPI, err := ListAll[SubscriptionAPIModel](ctx, self.client, query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to list %s, got error: %s", subscriptions.String(), err))
+		return
+	}
+
+	// Save subscriptions into Terraform state
+	resp.Diagnostics.Append(subscriptions.FromAPI(ctx, subscriptionsFromAPI)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &subscriptions)...)
+}
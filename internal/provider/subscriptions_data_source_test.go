@@ -0,0 +1,67 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubscriptionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+variable "test_abx_action_id" {
+  description = "ABX action to use for testing subscriptions."
+  type        = string
+}
+
+resource "aria_subscription" "test" {
+  name           = "ARIA_PROVIDER_TEST_SUBSCRIPTIONS"
+  description    = "Subscription listed by the data source"
+  type           = "RUNNABLE"
+  runnable_type  = "extensibility.abx"
+  runnable_id    = var.test_abx_action_id
+  event_topic_id = "compute.provision.post"
+  project_ids    = []
+  blocking       = true
+  contextual     = false
+  disabled       = true # Its safer
+  timeout        = 0
+  priority       = 10
+}
+
+data "aria_subscriptions" "filtered" {
+  filter = "name eq '${aria_subscription.test.name}'"
+
+  lifecycle {
+    postcondition {
+      condition     = join(",", self.subscriptions[*].id) == aria_subscription.test.id
+      error_message = "Subscriptions must be [${aria_subscription.test.id}]"
+    }
+  }
+}
+
+data "aria_subscriptions" "searched" {
+  search = "aria_provider_test_subscriptions"
+
+  depends_on = [aria_subscription.test]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aria_subscriptions.filtered", "subscriptions.#", "1"),
+					resource.TestCheckResourceAttr("data.aria_subscriptions.filtered", "subscriptions.0.type", "RUNNABLE"),
+					resource.TestCheckResourceAttr("data.aria_subscriptions.filtered", "subscriptions.0.event_topic_id", "compute.provision.post"),
+					resource.TestCheckResourceAttr("data.aria_subscriptions.filtered", "subscriptions.0.project_ids.#", "0"),
+					resource.TestCheckResourceAttr("data.aria_subscriptions.filtered", "subscriptions.0.disabled", "true"),
+					resource.TestCheckResourceAttr("data.aria_subscriptions.searched", "subscriptions.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,95 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SubscriptionsModel describes the data source data model.
+type SubscriptionsModel struct {
+	Filter types.String `tfsdk:"filter"`
+	Search types.String `tfsdk:"search"`
+
+	Subscriptions []SubscriptionSummaryModel `tfsdk:"subscriptions"`
+}
+
+// SubscriptionSummaryModel describes the subscriptions returned by the data source.
+type SubscriptionSummaryModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Type         types.String `tfsdk:"type"`
+	RunnableType types.String `tfsdk:"runnable_type"`
+	RunnableId   types.String `tfsdk:"runnable_id"`
+	EventTopicId types.String `tfsdk:"event_topic_id"`
+	ProjectIds   types.Set    `tfsdk:"project_ids"`
+	Blocking     types.Bool   `tfsdk:"blocking"`
+	Disabled     types.Bool   `tfsdk:"disabled"`
+	Priority     types.Int64  `tfsdk:"priority"`
+	OrgId        types.String `tfsdk:"org_id"`
+}
+
+func (self SubscriptionsModel) String() string {
+	return fmt.Sprintf(
+		"Subscriptions (filter %q, search %q)",
+		self.Filter.ValueString(),
+		self.Search.ValueString())
+}
+
+func (self SubscriptionsModel) ListQuery() ListQuery {
+	return ListQuery{
+		Path:       SubscriptionModel{}.ListPath(),
+		Pagination: LIST_PAGINATION_PAGE,
+		Filter:     self.Filter.ValueString(),
+	}
+}
+
+func (self *SubscriptionsModel) FromAPI(
+	ctx context.Context,
+	raws []SubscriptionAPIModel,
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	self.Subscriptions = []SubscriptionSummaryModel{}
+	for _, raw := range raws {
+		if MatchSearch(self.Search.ValueString(), raw.Name) {
+			subscription := SubscriptionSummaryModel{}
+			diags.Append(subscription.FromAPI(ctx, raw)...)
+			self.Subscriptions = append(self.Subscriptions, subscription)
+		}
+	}
+	return diags
+}
+
+func (self *SubscriptionSummaryModel) FromAPI(
+	ctx context.Context,
+	raw SubscriptionAPIModel,
+) diag.Diagnostics {
+	// Convert the constraint from "nil" to empty list
+	projectIds := raw.Constraints["projectId"]
+	if projectIds == nil {
+		projectIds = []string{}
+	}
+
+	var diags diag.Diagnostics
+	self.ProjectIds, diags = types.SetValueFrom(ctx, types.StringType, projectIds)
+
+	self.Id = types.StringValue(raw.Id)
+	self.Name = types.StringValue(raw.Name)
+	self.Description = types.StringValue(raw.Description)
+	self.Type = types.StringValue(raw.Type)
+	self.RunnableType = types.StringValue(raw.RunnableType)
+	self.RunnableId = types.StringValue(raw.RunnableId)
+	self.EventTopicId = types.StringValue(raw.EventTopicId)
+	self.Blocking = types.BoolValue(raw.Blocking)
+	self.Disabled = types.BoolValue(raw.Disabled)
+	self.Priority = types.Int64Value(raw.Priority)
+	self.OrgId = types.StringValue(raw.OrgId)
+
+	return diags
+}
@@ -0,0 +1,64 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func SubscriptionsDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Subscriptions data source (all pages)",
+		Attributes: map[string]schema.Attribute{
+			"filter": ListFilterSchema(),
+			"search": ListSearchSchema(),
+			"subscriptions": schema.ListNestedAttribute{
+				MarkdownDescription: "Subscriptions",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": ComputedIdentifierSchema(""),
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Subscription name",
+							Computed:            true,
+						},
+						"description": ComputedDescriptionSchema(),
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Subscription type, either `RUNNABLE` or " +
+								"`SUBSCRIBABLE`",
+							Computed: true,
+						},
+						"runnable_type": schema.StringAttribute{
+							MarkdownDescription: "Runnable type, either `extensibility.abx` or " +
+								"`extensibility.vro`",
+							Computed: true,
+						},
+						"runnable_id":    ComputedIdentifierSchema("Runnable identifier"),
+						"event_topic_id": ComputedIdentifierSchema("Event topic identifier"),
+						"project_ids": schema.SetAttribute{
+							MarkdownDescription: "Restrict to given projects " +
+								"(an empty list means all)",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"blocking": schema.BoolAttribute{
+							MarkdownDescription: "TODO",
+							Computed:            true,
+						},
+						"disabled": schema.BoolAttribute{
+							MarkdownDescription: "TODO",
+							Computed:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "TODO",
+							Computed:            true,
+						},
+						"org_id": ComputedOrganizationIdSchema(),
+					},
+				},
+			},
+		},
+	}
+}
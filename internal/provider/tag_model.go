@@ -26,12 +26,6 @@ type TagAPIModel struct {
 	Value string `json:"value"`
 }
 
-type TagListAPIModel struct {
-	Content          []TagAPIModel `json:"content"`
-	TotalElements    int           `json:"totalElements"`
-	NumberOfElements int           `json:"numberOfElements"`
-}
-
 func (self TagModel) String() string {
 	return fmt.Sprintf(
 		"Tag %s (%s)",
@@ -50,6 +44,15 @@ func (self TagModel) ListPath() string {
 	return "iaas/api/tags"
 }
 
+// Query the tags list filtered by identifier.
+func (self TagModel) ListQuery() ListQuery {
+	return ListQuery{
+		Path:       self.ListPath(),
+		Pagination: LIST_PAGINATION_ODATA,
+		Filter:     "id eq " + self.Id.ValueString(),
+	}
+}
+
 func (self TagModel) CreatePath() string {
 	return "iaas/api/tags"
 }
@@ -63,7 +63,7 @@ func (self *TagResource) Create(
 
 	var tagFromAPI TagAPIModel
 	path := tag.CreatePath()
-	response, err := self.client.R(path).SetBody(tag.ToAPI()).SetResult(&tagFromAPI).Post(path)
+	response, err := self.client.R(ctx, path).SetBody(tag.ToAPI()).SetResult(&tagFromAPI).Post(path)
 	err = self.client.HandleAPIResponse(response, err, []int{201})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -90,15 +90,8 @@ func (self *TagResource) Read(
 		return
 	}
 
-	// TODO Read by filtering tag list by ID
-	var listFromAPI TagListAPIModel
-	listPath := tag.ListPath()
-	response, err := self.client.R(listPath).
-		SetQueryParam("$filter", fmt.Sprintf("id eq %s", tag.Id.ValueString())).
-		SetQueryParam("$top", "2"). // Make it possible to know if filter works properly
-		SetResult(&listFromAPI).
-		Get(listPath)
-	err = self.client.HandleAPIResponse(response, err, []int{200})
+	// Read by filtering tag list by ID
+	tagsFromAPI, err := ListAll[TagAPIModel](ctx, self.client, tag.ListQuery())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client error",
@@ -106,26 +99,25 @@ func (self *TagResource) Read(
 		return
 	}
 
-	// Do not rely on NumberOfElements
-	if len(listFromAPI.Content) == 0 {
+	if len(tagsFromAPI) == 0 {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	// Neither TotalElements
-	if len(listFromAPI.Content) > 1 {
+	// Make it possible to know if filter works properly
+	if len(tagsFromAPI) > 1 {
 		resp.Diagnostics.AddError(
 			"Client error",
 			fmt.Sprintf(
 				"Expected one and only one tag matching %s ID, found: %d",
-				tag.String(), listFromAPI.TotalElements,
+				tag.String(), len(tagsFromAPI),
 			),
 		)
 		return
 	}
 
 	// Save updated tag into Terraform state
-	tag.FromAPI(listFromAPI.Content[0])
+	tag.FromAPI(tagsFromAPI[0])
 	resp.Diagnostics.Append(resp.State.Set(ctx, &tag)...)
 }
 
@@ -155,7 +147,7 @@ func (self *TagResource) Delete(
 	var tag TagModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &tag)...)
 	if !resp.Diagnostics.HasError() && !tag.KeepOnDestroy.ValueBool() {
-		resp.Diagnostics.Append(self.client.DeleteIt(&tag)...)
+		resp.Diagnostics.Append(self.client.DeleteIt(ctx, &tag)...)
 	}
 }
 
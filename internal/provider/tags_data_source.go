@@ -0,0 +1,72 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TagsDataSource{}
+
+func NewTagsDataSource() datasource.DataSource {
+	return &TagsDataSource{}
+}
+
+// TagsDataSource defines the data source implementation.
+type TagsDataSource struct {
+	client *AriaClient
+}
+
+func (self *TagsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_tags"
+}
+
+func (self *TagsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = TagsDataSourceSchema()
+}
+
+func (self *TagsDataSource) Configure(
+	ctx context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	self.client = GetDataSourceClient(ctx, req, resp)
+}
+
+func (self *TagsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	// Read Terraform configuration data into the model
+	var tags TagsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsFromAPI, err := ListAll[TagAPIModel](ctx, self.client, tags.ListQuery())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client error",
+			fmt.Sprintf("Unable to list %s, got error: %s", tags.String(), err))
+		return
+	}
+
+	// Save tags into Terraform state
+	tags.FromAPI(tagsFromAPI)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &tags)...)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `
+resource "aria_tag" "test" {
+  key   = "ARIA_PROVIDER_TEST_TAGS"
+  value = "some-value"
+}
+
+data "aria_tags" "filtered" {
+  filter = "key eq '${aria_tag.test.key}'"
+
+  lifecycle {
+    postcondition {
+      condition     = join(",", self.tags[*].id) == aria_tag.test.id
+      error_message = "Tags must be [${aria_tag.test.id}]"
+    }
+  }
+}
+
+data "aria_tags" "searched" {
+  search = "SOME-VALUE"
+
+  depends_on = [aria_tag.test]
+
+  lifecycle {
+    postcondition {
+      condition     = contains(self.tags[*].id, aria_tag.test.id)
+      error_message = "Tags must include ${aria_tag.test.id}"
+    }
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aria_tags.filtered", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.aria_tags.filtered", "tags.0.key", "ARIA_PROVIDER_TEST_TAGS"),
+					resource.TestCheckResourceAttr("data.aria_tags.filtered", "tags.0.value", "some-value"),
+				),
+			},
+		},
+	})
+}
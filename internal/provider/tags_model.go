@@ -0,0 +1,53 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TagsModel describes the data source data model.
+type TagsModel struct {
+	Filter types.String `tfsdk:"filter"`
+	Search types.String `tfsdk:"search"`
+
+	Tags []TagSummaryModel `tfsdk:"tags"`
+}
+
+// TagSummaryModel describes the tags returned by the data source.
+type TagSummaryModel struct {
+	Id    types.String `tfsdk:"id"`
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (self TagsModel) String() string {
+	return fmt.Sprintf(
+		"Tags (filter %q, search %q)",
+		self.Filter.ValueString(),
+		self.Search.ValueString())
+}
+
+func (self TagsModel) ListQuery() ListQuery {
+	return ListQuery{
+		Path:       TagModel{}.ListPath(),
+		Pagination: LIST_PAGINATION_ODATA,
+		Filter:     self.Filter.ValueString(),
+	}
+}
+
+func (self *TagsModel) FromAPI(raws []TagAPIModel) {
+	self.Tags = []TagSummaryModel{}
+	for _, raw := range raws {
+		if MatchSearch(self.Search.ValueString(), raw.Key, raw.Value) {
+			self.Tags = append(self.Tags, TagSummaryModel{
+				Id:    types.StringValue(raw.Id),
+				Key:   types.StringValue(raw.Key),
+				Value: types.StringValue(raw.Value),
+			})
+		}
+	}
+}
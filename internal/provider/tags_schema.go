@@ -0,0 +1,39 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func TagsDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Tags data source (all pages)",
+		Attributes: map[string]schema.Attribute{
+			"filter": ListFilterSchema(),
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Keep only the tags whose key or value contains this text " +
+					"(case insensitive)",
+				Optional: true,
+			},
+			"tags": schema.ListNestedAttribute{
+				MarkdownDescription: "Tags",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": ComputedIdentifierSchema(""),
+						"key": schema.StringAttribute{
+							MarkdownDescription: "Key",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Value",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,303 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const ACCESS_TOKEN_PATH = "iaas/api/login"
+const PASSWORD_LOGIN_PATH = "csp/gateway/am/api/login"
+const CLIENT_CREDENTIALS_PATH = "csp/gateway/am/api/auth/authorize"
+
+// Authentication modes.
+const AUTH_MODE_REFRESH_TOKEN = "refresh_token"
+const AUTH_MODE_PASSWORD = "password"
+const AUTH_MODE_CLIENT_CREDENTIALS = "client_credentials"
+
+var AUTH_MODES = []string{AUTH_MODE_REFRESH_TOKEN, AUTH_MODE_PASSWORD, AUTH_MODE_CLIENT_CREDENTIALS}
+
+// Paths of the requests made to retrieve tokens (these are not authenticated).
+var AUTH_PATHS = []string{ACCESS_TOKEN_PATH, PASSWORD_LOGIN_PATH, CLIENT_CREDENTIALS_PATH}
+
+// Renew the access token when it expires in less than this duration.
+const ACCESS_TOKEN_RENEWAL_MARGIN = 5 * time.Minute
+
+// Lifetime assumed when expiration cannot be retrieved from the access token.
+const ACCESS_TOKEN_DEFAULT_LIFETIME = 30 * time.Minute
+
+type AccessTokenResponse struct {
+	TokenType string `json:"tokenType"`
+	Token     string `json:"token"`
+}
+
+type PasswordLoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Domain   string `json:"domain,omitempty"`
+}
+
+type PasswordLoginResponse struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type ClientCredentialsResponse struct {
+	TokenType   string `json:"token_type"`
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// Retrieve a valid access token (requested using the refresh token if necessary).
+func (self *AriaClient) GetAccessToken() diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	// Access token is given, try to figure out when it will expire
+	if len(self.AccessToken) > 0 && self.AccessTokenExpiresAt.IsZero() {
+		if expiresAt, err := GetAccessTokenExpiration(self.AccessToken); err == nil {
+			self.AccessTokenExpiresAt = expiresAt
+		}
+	}
+
+	if _, err := self.EnsureAccessToken(self.Context); err != nil {
+		diags.AddError("Unable to retrieve a valid access token", err.Error())
+		return diags
+	}
+
+	if len(self.AccessToken) == 0 {
+		diags.AddError(
+			"Empty Access Token",
+			"Access Token is empty, will be unable to make API calls")
+	}
+
+	return diags
+}
+
+// Return true if the access token can be renewed (using credentials or the refresh token).
+func (self *AriaClient) CanRenewAccessToken() bool {
+	switch self.AuthMode {
+	case AUTH_MODE_PASSWORD:
+		return len(self.Username) > 0 && len(self.Password) > 0
+	case AUTH_MODE_CLIENT_CREDENTIALS:
+		return len(self.ClientId) > 0 && len(self.ClientSecret) > 0
+	}
+	return len(self.RefreshToken) > 0
+}
+
+// Return true if the access token is expired or about to expire.
+func (self *AriaClient) IsAccessTokenExpiring() bool {
+	if self.AccessTokenExpiresAt.IsZero() {
+		return false
+	}
+	return time.Now().Add(ACCESS_TOKEN_RENEWAL_MARGIN).After(self.AccessTokenExpiresAt)
+}
+
+// Return the access token, renewed if missing or about to expire (and it can be renewed).
+// The current access token is returned along the error if renewal failed.
+// Safe for concurrent use, only one renewal is made when many requests are waiting for it.
+func (self *AriaClient) EnsureAccessToken(ctx context.Context) (string, error) {
+	self.tokenMutex.Lock()
+	defer self.tokenMutex.Unlock()
+
+	if self.CanRenewAccessToken() && (len(self.AccessToken) == 0 || self.IsAccessTokenExpiring()) {
+		if err := self.RenewAccessToken(ctx); err != nil {
+			return self.AccessToken, err
+		}
+	}
+	return self.AccessToken, nil
+}
+
+// Forget the access token (if its the given one) to force its renewal on next request.
+// Safe for concurrent use, a token renewed in the meantime by another request is kept.
+func (self *AriaClient) InvalidateAccessToken(token string) {
+	self.tokenMutex.Lock()
+	defer self.tokenMutex.Unlock()
+
+	if self.AccessToken == token {
+		self.AccessToken = ""
+		self.AccessTokenExpiresAt = time.Time{}
+	}
+}
+
+// Retrieve a new access token as defined by the authentication mode.
+// Caller must hold the token mutex.
+func (self *AriaClient) RenewAccessToken(ctx context.Context) error {
+	switch self.AuthMode {
+	case AUTH_MODE_CLIENT_CREDENTIALS:
+		return self.RequestClientCredentialsToken(ctx)
+	case AUTH_MODE_PASSWORD:
+		// Login every time, the previous refresh token may have been revoked in the meantime
+		if err := self.RequestRefreshToken(ctx); err != nil {
+			return err
+		}
+	}
+	return self.ExchangeRefreshToken(ctx)
+}
+
+// Login with username and password (CSP/vIDM) to retrieve a new refresh token.
+// Caller must hold the token mutex.
+func (self *AriaClient) RequestRefreshToken(ctx context.Context) error {
+	self.Debug("Login as %s at %s to retrieve a refresh token", self.Username, self.Host)
+
+	var login PasswordLoginResponse
+	path := PASSWORD_LOGIN_PATH
+	response, err := self.R(ctx, path).
+		SetHeader("Content-Type", "application/json").
+		SetQueryParam("access_token", "").
+		SetBody(PasswordLoginRequest{
+			Username: self.Username,
+			Password: self.Password,
+			Domain:   self.Domain,
+		}).
+		SetResult(&login).
+		Post(path)
+	err = self.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		return err
+	}
+	if len(login.RefreshToken) == 0 {
+		return errors.New("API returned an empty refresh token")
+	}
+
+	self.RefreshToken = login.RefreshToken
+	return nil
+}
+
+// Exchange the refresh token for a new access token. Caller must hold the token mutex.
+func (self *AriaClient) ExchangeRefreshToken(ctx context.Context) error {
+	self.Debug("Requesting a new API access token at %s", self.Host)
+
+	var token AccessTokenResponse
+	path := ACCESS_TOKEN_PATH
+	response, err := self.R(ctx, path).
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]string{"refreshToken": self.RefreshToken}).
+		SetResult(&token).
+		Post(path)
+	err = self.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		return err
+	}
+	if len(token.Token) == 0 {
+		return errors.New("API returned an empty access token")
+	}
+
+	self.SetAccessToken(token.Token, 0)
+	return nil
+}
+
+// Request a new access token using OAuth client credentials grant (service accounts).
+// Caller must hold the token mutex.
+func (self *AriaClient) RequestClientCredentialsToken(ctx context.Context) error {
+	self.Debug("Requesting a new API access token for client %s at %s", self.ClientId, self.Host)
+
+	var token ClientCredentialsResponse
+	path := CLIENT_CREDENTIALS_PATH
+	response, err := self.R(ctx, path).
+		SetBasicAuth(self.ClientId, self.ClientSecret).
+		SetFormData(map[string]string{"grant_type": "client_credentials"}).
+		SetResult(&token).
+		Post(path)
+	err = self.HandleAPIResponse(response, err, []int{200})
+	if err != nil {
+		return err
+	}
+	if len(token.AccessToken) == 0 {
+		return errors.New("API returned an empty access token")
+	}
+
+	self.SetAccessToken(token.AccessToken, time.Duration(token.ExpiresIn)*time.Second)
+	return nil
+}
+
+// Store a new access token and its expiration, retrieved from its claims if possible, else
+// computed from given lifetime (or default lifetime if zero). Caller must hold the token mutex.
+func (self *AriaClient) SetAccessToken(token string, lifetime time.Duration) {
+	expiresAt, err := GetAccessTokenExpiration(token)
+	if err != nil {
+		if lifetime <= 0 {
+			lifetime = ACCESS_TOKEN_DEFAULT_LIFETIME
+		}
+		self.Debug(
+			"Unable to retrieve access token expiration (%s), assuming it expires in %s",
+			err, lifetime)
+		expiresAt = time.Now().Add(lifetime)
+	}
+
+	self.AccessToken = token
+	self.AccessTokenExpiresAt = expiresAt
+	self.Debug("Retrieved a new API access token expiring at %s", expiresAt)
+}
+
+// Set an up-to-date access token on the request (called before every attempt).
+// Registered as a request middleware of the resty client.
+func (self *AriaClient) AuthenticateRequest(client *resty.Client, request *resty.Request) error {
+	if IsAccessTokenRequest(request) {
+		return nil
+	}
+
+	token, err := self.EnsureAccessToken(request.Context())
+	if err != nil {
+		// Send the request anyway, API will answer with an explicit unauthorized error
+		self.Error("Unable to renew API access token, got error: %s", err)
+	}
+	request.SetAuthToken(token)
+	return nil
+}
+
+// Retry once a request that was rejected as unauthorized, with a renewed access token.
+// Registered as a retry condition of the resty client.
+func (self *AriaClient) ShouldRetryUnauthorized(response *resty.Response, err error) bool {
+	if response == nil || response.StatusCode() != 401 || !self.CanRenewAccessToken() {
+		return false
+	}
+	if IsAccessTokenRequest(response.Request) || !MarkUnauthorizedRetry(response.Request) {
+		return false
+	}
+
+	self.Debug("API call to %s is unauthorized, renewing access token", response.Request.URL)
+	self.InvalidateAccessToken(response.Request.Token)
+	return true
+}
+
+// Return true if this is a request used to retrieve a token (refresh or access).
+func IsAccessTokenRequest(request *resty.Request) bool {
+	path, _, _ := strings.Cut(request.URL, "?")
+	return slices.ContainsFunc(AUTH_PATHS, func(authPath string) bool {
+		return strings.HasSuffix(path, authPath)
+	})
+}
+
+// Return the expiration time stored in the claims of a JWT access token.
+func GetAccessTokenExpiration(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.New("access token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var claims struct {
+		Expiration int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, err
+	}
+	if claims.Expiration == 0 {
+		return time.Time{}, errors.New("access token has no expiration claim")
+	}
+
+	return time.Unix(claims.Expiration, 0), nil
+}
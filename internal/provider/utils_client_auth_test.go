@@ -0,0 +1,187 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func MakeTestAccessToken(subject string, expiresAt time.Time) string {
+	claims := fmt.Sprintf(`{"sub":"%s","exp":%d}`, subject, expiresAt.Unix())
+	return "e30." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".signature"
+}
+
+// Return an Aria client talking to a fake API rejecting tokens not issued by the latest login.
+func NewTestAuthClient(t *testing.T, lifetime time.Duration) (*AriaClient, *atomic.Int32) {
+	var logins atomic.Int32
+	var lock sync.Mutex
+	var validToken string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/"+ACCESS_TOKEN_PATH {
+			count := logins.Add(1)
+			validToken = MakeTestAccessToken(fmt.Sprintf("login-%d", count), time.Now().Add(lifetime))
+			fmt.Fprintf(w, `{"tokenType":"Bearer","token":"%s"}`, validToken)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer "+validToken {
+			w.WriteHeader(401)
+			fmt.Fprint(w, `{"message":"Unauthorized"}`)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+	t.Cleanup(server.Close)
+
+	client := AriaClient{
+		Host:               server.URL,
+		RefreshToken:       "some-refresh-token",
+		Context:            t.Context(),
+		OKAPICallsLogLevel: "TRACE",
+		KOAPICallsLogLevel: "TRACE",
+	}
+	CheckDiagnostics(t, client.Init(), "", "")
+	return &client, &logins
+}
+
+func TestGetAccessTokenExpiration(t *testing.T) {
+	expiresAt := time.Unix(1893456000, 0)
+	result, err := GetAccessTokenExpiration(MakeTestAccessToken("test", expiresAt))
+	CheckEqual(t, err, nil)
+	CheckEqual(t, result, expiresAt)
+
+	_, err = GetAccessTokenExpiration("not-a-jwt")
+	CheckEqual(t, err.Error(), "access token is not a JWT")
+}
+
+func TestAriaClientRenewExpiringAccessToken(t *testing.T) {
+	client, logins := NewTestAuthClient(t, time.Minute)
+	CheckEqual(t, logins.Load(), int32(1))
+
+	// Token expires within the renewal margin, must be renewed before each request
+	for attempt := 0; attempt < 2; attempt++ {
+		response, err := client.R(t.Context(), "iaas/api/projects").Get("iaas/api/projects")
+		CheckEqual(t, client.HandleAPIResponse(response, err, []int{200}), nil)
+	}
+	CheckEqual(t, logins.Load(), int32(3))
+}
+
+func TestAriaClientRetryUnauthorized(t *testing.T) {
+	client, logins := NewTestAuthClient(t, time.Hour)
+
+	// Token is revoked by the API, must be renewed once concurrent requests are rejected
+	client.tokenMutex.Lock()
+	client.AccessToken = MakeTestAccessToken("revoked", time.Now().Add(time.Hour))
+	client.tokenMutex.Unlock()
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			response, err := client.R(t.Context(), "iaas/api/projects").Get("iaas/api/projects")
+			CheckEqual(t, client.HandleAPIResponse(response, err, []int{200}), nil)
+		}()
+	}
+	wg.Wait()
+	CheckEqual(t, logins.Load(), int32(2))
+}
+
+// Return an Aria client talking to a fake API accepting only the token issued for given credentials.
+func NewTestLoginClient(t *testing.T, client AriaClient) (*AriaClient, *atomic.Int32) {
+	var logins atomic.Int32
+	accessToken := MakeTestAccessToken("login", time.Now().Add(time.Hour))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/" + PASSWORD_LOGIN_PATH:
+			var login PasswordLoginRequest
+			if err := json.NewDecoder(r.Body).Decode(&login); err != nil ||
+				login != (PasswordLoginRequest{"user", "secret", "System Domain"}) {
+				w.WriteHeader(401)
+				return
+			}
+			logins.Add(1)
+			fmt.Fprint(w, `{"refresh_token":"some-refresh-token"}`)
+		case "/" + ACCESS_TOKEN_PATH:
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil ||
+				body["refreshToken"] != "some-refresh-token" {
+				w.WriteHeader(401)
+				return
+			}
+			fmt.Fprintf(w, `{"tokenType":"Bearer","token":"%s"}`, accessToken)
+		case "/" + CLIENT_CREDENTIALS_PATH:
+			clientId, clientSecret, ok := r.BasicAuth()
+			if !ok || clientId != "some-client" || clientSecret != "secret" ||
+				r.FormValue("grant_type") != "client_credentials" {
+				w.WriteHeader(401)
+				return
+			}
+			logins.Add(1)
+			fmt.Fprintf(
+				w, `{"token_type":"bearer","access_token":"%s","expires_in":3600}`, accessToken)
+		default:
+			if r.Header.Get("Authorization") != "Bearer "+accessToken {
+				w.WriteHeader(401)
+			}
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client.Host = server.URL
+	client.Context = t.Context()
+	client.OKAPICallsLogLevel = "TRACE"
+	client.KOAPICallsLogLevel = "TRACE"
+	CheckDiagnostics(t, client.Init(), "", "")
+	return &client, &logins
+}
+
+func TestAriaClientPasswordLogin(t *testing.T) {
+	client, logins := NewTestLoginClient(t, AriaClient{
+		AuthMode: AUTH_MODE_PASSWORD,
+		Username: "user",
+		Password: "secret",
+		Domain:   "System Domain",
+	})
+	response, err := client.R(t.Context(), "iaas/api/projects").Get("iaas/api/projects")
+	CheckEqual(t, client.HandleAPIResponse(response, err, []int{200}), nil)
+	CheckEqual(t, client.RefreshToken, "some-refresh-token")
+	CheckEqual(t, logins.Load(), int32(1))
+}
+
+func TestAriaClientClientCredentialsLogin(t *testing.T) {
+	client, logins := NewTestLoginClient(t, AriaClient{
+		AuthMode:     AUTH_MODE_CLIENT_CREDENTIALS,
+		ClientId:     "some-client",
+		ClientSecret: "secret",
+	})
+	response, err := client.R(t.Context(), "iaas/api/projects").Get("iaas/api/projects")
+	CheckEqual(t, client.HandleAPIResponse(response, err, []int{200}), nil)
+	CheckEqual(t, logins.Load(), int32(1))
+}
+
+func TestAriaClientCheckConfigCredentials(t *testing.T) {
+	client := AriaClient{Host: "https://aria.local", AuthMode: AUTH_MODE_PASSWORD, Username: "user"}
+	CheckDiagnostics(
+		t, client.CheckConfig(), "", "Username and password are required to login with password")
+
+	client = AriaClient{Host: "https://aria.local", AuthMode: AUTH_MODE_CLIENT_CREDENTIALS}
+	CheckDiagnostics(
+		t, client.CheckConfig(), "",
+		"Client ID and secret are required to login with client credentials")
+}
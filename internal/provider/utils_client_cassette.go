@@ -0,0 +1,393 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Cassette modes (API calls are made as usual if empty).
+const CASSETTE_MODE_RECORD = "record"
+const CASSETTE_MODE_REPLAY = "replay"
+
+var CASSETTE_MODES = []string{CASSETTE_MODE_RECORD, CASSETTE_MODE_REPLAY}
+
+// Host written in the cassettes instead of the recorded one (and used for replaying them).
+const CASSETTE_HOST = "https://aria.cassette.invalid"
+
+// Interval between polls when replaying a cassette (the recorded responses are replayed at once).
+const CASSETTE_POLL_INTERVAL = time.Millisecond
+
+// API calls recorded into a file (YAML), to be replayed later (without any Aria instance).
+type Cassette struct {
+	File string `yaml:"-"`
+	Mode string `yaml:"-"`
+
+	// Variables of the recorded tests (e.g. identifiers of the seeded instances).
+	Variables map[string]string `yaml:"variables,omitempty"`
+
+	Interactions []CassetteInteraction `yaml:"interactions"`
+
+	mutex sync.Mutex
+
+	// Replay: Indexes of the interactions per request key and the ones already replayed.
+	byKey    map[string][]int
+	replayed map[int]bool
+}
+
+// An API call, with tokens, secrets and host scrubbed.
+type CassetteInteraction struct {
+	Operation       string              `yaml:"operation,omitempty"`
+	Method          string              `yaml:"method"`
+	URL             string              `yaml:"url"`
+	RequestBody     string              `yaml:"request_body,omitempty"`
+	StatusCode      int                 `yaml:"status_code"`
+	ResponseHeaders map[string][]string `yaml:"response_headers,omitempty"`
+	ResponseBody    string              `yaml:"response_body,omitempty"`
+
+	// Encoding of the response body, base64 if binary (e.g. icons) else empty.
+	ResponseEncoding string `yaml:"response_encoding,omitempty"`
+}
+
+// Tag the API calls made with the context with the operation (e.g. creating a resource action), so
+// that concurrent operations on the same instance replay their own API calls (whatever the order).
+func WithCassetteOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, cassetteOperationKey{}, operation)
+}
+
+type cassetteOperationKey struct{}
+
+// Cassettes are shared by the clients of the process (the provider is configured many times by
+// the acceptance tests), key is the file.
+var cassettes = map[string]*Cassette{}
+var cassettesMutex sync.Mutex
+
+// Return the cassette stored in file (opened once). Cassette is initially empty when recording.
+func OpenCassette(file string, mode string) (*Cassette, error) {
+	cassettesMutex.Lock()
+	defer cassettesMutex.Unlock()
+
+	if cassette, found := cassettes[file]; found {
+		if cassette.Mode != mode {
+			return nil, fmt.Errorf(
+				"cassette %s is already opened in %s mode", file, cassette.Mode)
+		}
+		return cassette, nil
+	}
+
+	cassette := &Cassette{File: file, Mode: mode, Variables: map[string]string{}}
+	switch mode {
+	case CASSETTE_MODE_RECORD:
+	case CASSETTE_MODE_REPLAY:
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(content, cassette); err != nil {
+			return nil, fmt.Errorf("unable to parse cassette %s: %w", file, err)
+		}
+		cassette.byKey = map[string][]int{}
+		cassette.replayed = map[int]bool{}
+		for index, interaction := range cassette.Interactions {
+			key := interaction.Key()
+			cassette.byKey[key] = append(cassette.byKey[key], index)
+		}
+	default:
+		return nil, fmt.Errorf(
+			"cassette mode %s is not one of %s", mode, strings.Join(CASSETTE_MODES, ", "))
+	}
+
+	cassettes[file] = cassette
+	return cassette, nil
+}
+
+// Forget the cassette (opening it again will start a new recording or replay).
+func CloseCassette(file string) {
+	cassettesMutex.Lock()
+	defer cassettesMutex.Unlock()
+	delete(cassettes, file)
+}
+
+// Append the interaction and save the cassette (so nothing is lost if the process crashes).
+func (self *Cassette) Record(interaction CassetteInteraction) error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	self.Interactions = append(self.Interactions, interaction)
+	return self.save()
+}
+
+// Save the cassette (e.g. after setting its variables).
+func (self *Cassette) Save() error {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.save()
+}
+
+func (self *Cassette) save() error {
+	content, err := yaml.Marshal(self)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(self.File), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(self.File, content, 0o644)
+}
+
+// Return the next interaction recorded for the request key (see Key), preferring the one
+// with the same body (concurrent requests are not recorded in a predictable order).
+// The last one is replayed again when exhausted (e.g. polling an instance).
+func (self *Cassette) Replay(key string, body string) (CassetteInteraction, bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	indexes := self.byKey[key]
+	if len(indexes) == 0 {
+		return CassetteInteraction{}, false
+	}
+	selected := -1
+	for _, index := range indexes {
+		if self.replayed[index] {
+			continue
+		}
+		if self.Interactions[index].RequestBody == body {
+			selected = index
+			break
+		}
+		if selected < 0 {
+			selected = index
+		}
+	}
+	if selected < 0 {
+		selected = indexes[len(indexes)-1]
+	}
+	self.replayed[selected] = true
+	return self.Interactions[selected], true
+}
+
+// HTTP transport recording the API calls into a cassette or replaying them from a cassette.
+type CassetteTransport struct {
+	// Transport making the requests when recording (unused when replaying).
+	Base http.RoundTripper
+
+	Cassette *Cassette
+
+	// Fields scrubbed from the bodies (same as the API calls logs).
+	SensitiveFields SensitiveFields
+}
+
+func (self *CassetteTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	requestBody, err := ReadRequestBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := CassetteInteraction{
+		Method: request.Method,
+		URL:    self.ScrubURL(request.URL),
+	}
+	if operation, ok := request.Context().Value(cassetteOperationKey{}).(string); ok {
+		interaction.Operation = operation
+	}
+
+	host := request.URL.Scheme + "://" + request.URL.Host
+	interaction.RequestBody, _ = self.ScrubBody(
+		request.URL.Path, requestBody, request.Header, host)
+
+	if self.Cassette.Mode == CASSETTE_MODE_REPLAY {
+		key := interaction.Key()
+		recorded, found := self.Cassette.Replay(key, interaction.RequestBody)
+		if !found {
+			return nil, fmt.Errorf(
+				"cassette %s has no recorded call for %s", self.Cassette.File, key)
+		}
+		return recorded.Response(request)
+	}
+
+	response, err := self.Base.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction.StatusCode = response.StatusCode
+	interaction.ResponseHeaders = ScrubHeaders(response.Header, host)
+	interaction.ResponseBody, interaction.ResponseEncoding = self.ScrubBody(
+		request.URL.Path, responseBody, response.Header, host)
+	if err := self.Cassette.Record(interaction); err != nil {
+		return nil, fmt.Errorf("unable to save cassette %s: %w", self.Cassette.File, err)
+	}
+	return response, nil
+}
+
+// Return the path and query of the URL, with sensitive query parameters redacted (and sorted).
+func (self *CassetteTransport) ScrubURL(location *url.URL) string {
+	query := location.Query()
+	for key := range query {
+		if self.SensitiveFields.IsSensitive(key, "", location.Path, true) {
+			query.Set(key, REDACTED)
+		}
+	}
+	if len(query) == 0 {
+		return location.Path
+	}
+	return location.Path + "?" + query.Encode()
+}
+
+// Return the body (of the API path) with sensitive fields redacted and the host replaced by
+// CASSETTE_HOST, and its encoding (base64 if binary).
+func (self *CassetteTransport) ScrubBody(
+	path string,
+	body []byte,
+	headers http.Header,
+	host string,
+) (string, string) {
+	if len(body) == 0 {
+		return "", ""
+	}
+
+	contentType := headers.Get("Content-Type")
+	switch {
+	case strings.Contains(contentType, "json") || json.Valid(body):
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber() // Keep numbers as is (e.g. large integers)
+		var data any
+		if decoder.Decode(&data) == nil {
+			scrubbed := bytes.Buffer{}
+			encoder := json.NewEncoder(&scrubbed)
+			encoder.SetEscapeHTML(false)
+			encoder.SetIndent("", "  ")
+			if encoder.Encode(self.SensitiveFields.Redact(path, data)) == nil {
+				body = scrubbed.Bytes()
+			}
+		}
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for key := range form {
+				if self.SensitiveFields.IsSensitive(key, "", path, true) {
+					form.Set(key, REDACTED)
+				}
+			}
+			body = []byte(form.Encode())
+		}
+	}
+
+	if !utf8.Valid(body) {
+		return base64.StdEncoding.EncodeToString(body), "base64"
+	}
+	return strings.ReplaceAll(string(body), host, CASSETTE_HOST), ""
+}
+
+// Return the headers without the sensitive ones and with host replaced by CASSETTE_HOST.
+func ScrubHeaders(headers http.Header, host string) map[string][]string {
+	scrubbed := map[string][]string{}
+	for key, values := range headers {
+		if slices.ContainsFunc(SENSITIVE_API_HEADERS, func(header string) bool {
+			return strings.EqualFold(header, key)
+		}) {
+			continue
+		}
+		for _, value := range values {
+			scrubbed[key] = append(scrubbed[key], strings.ReplaceAll(value, host, CASSETTE_HOST))
+		}
+	}
+	return scrubbed
+}
+
+// Return the key used to match the requests (operation, method and URL).
+func (self CassetteInteraction) Key() string {
+	key := self.Method + " " + self.URL
+	if len(self.Operation) > 0 {
+		key += " (" + self.Operation + ")"
+	}
+	return key
+}
+
+// Return the recorded response to the request.
+func (self CassetteInteraction) Response(request *http.Request) (*http.Response, error) {
+	body := []byte(self.ResponseBody)
+	if self.ResponseEncoding == "base64" {
+		decoded, err := base64.StdEncoding.DecodeString(self.ResponseBody)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode recorded response: %w", err)
+		}
+		body = decoded
+	}
+
+	headers := http.Header{}
+	for key, values := range self.ResponseHeaders {
+		headers[key] = values
+	}
+	headers.Del("Content-Length") // Body may have been reformatted while scrubbing it
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", self.StatusCode, http.StatusText(self.StatusCode)),
+		StatusCode:    self.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}, nil
+}
+
+// Return the body of the request, restoring it so it can be sent.
+func ReadRequestBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil || request.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(request.Body)
+	request.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read request body: %w", err)
+	}
+	request.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// Return a transport recording or replaying the API calls using the cassette of the client.
+func (self *AriaClient) GetCassetteTransport(base http.RoundTripper) (http.RoundTripper, error) {
+	cassette, err := OpenCassette(self.CassetteFile, self.CassetteMode)
+	if err != nil {
+		return nil, err
+	}
+	return &CassetteTransport{
+		Base:            base,
+		Cassette:        cassette,
+		SensitiveFields: self.SensitiveFields,
+	}, nil
+}
+
+// Return the interval between polls (see GetPollInterval), nearly zero when replaying a cassette.
+func (self *AriaClient) GetPollInterval(
+	ctx context.Context,
+	maxInterval time.Duration,
+) time.Duration {
+	if self.CassetteMode == CASSETTE_MODE_REPLAY {
+		return CASSETTE_POLL_INTERVAL
+	}
+	return GetPollInterval(ctx, maxInterval)
+}
@@ -0,0 +1,157 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testCassetteIcon = []byte{0x89, 'P', 'N', 'G', 0x00, 0xff, 0xfe}
+
+// Return an Aria client recording or replaying its API calls (logging in with a refresh token).
+func NewTestCassetteClient(t *testing.T, host string, mode string, file string) *AriaClient {
+	client := AriaClient{
+		Host:               host,
+		RefreshToken:       "some-refresh-token",
+		Context:            t.Context(),
+		OKAPICallsLogLevel: "TRACE",
+		KOAPICallsLogLevel: "TRACE",
+		CassetteMode:       mode,
+		CassetteFile:       file,
+	}
+	t.Cleanup(func() { CloseCassette(file) })
+	CheckDiagnostics(t, client.Init(), "", "")
+	return &client
+}
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "cassette.yaml")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/" + ACCESS_TOKEN_PATH:
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"tokenType":"Bearer","token":"some-access-token"}`)
+		case "/iaas/api/projects/1":
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Location", "http://"+r.Host+"/iaas/api/projects/1")
+			fmt.Fprintf(w,
+				`{"id":"1","password":"p4ss","count":12345678901234567890,"href":"http://%s/x"}`,
+				r.Host)
+		case "/icon/api/icons/1":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write(testCassetteIcon)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	// Record the API calls
+	client := NewTestCassetteClient(t, server.URL, CASSETTE_MODE_RECORD, file)
+	for _, path := range []string{"iaas/api/projects/1", "icon/api/icons/1"} {
+		response, err := client.R(t.Context(), path).Get(path)
+		CheckEqual(t, client.HandleAPIResponse(response, err, []int{200}), nil)
+	}
+	CloseCassette(file)
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("Unable to read cassette, got error: %s", err)
+	}
+	for _, secret := range []string{"some-refresh-token", "some-access-token", "p4ss", server.URL} {
+		if strings.Contains(string(content), secret) {
+			t.Errorf("Cassette must not contain %s:\n%s", secret, content)
+		}
+	}
+	for _, expected := range []string{CASSETTE_HOST, "12345678901234567890", REDACTED} {
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Cassette must contain %s:\n%s", expected, content)
+		}
+	}
+
+	// Replay the API calls (without the API)
+	server.Close()
+	client = NewTestCassetteClient(t, CASSETTE_HOST, CASSETTE_MODE_REPLAY, file)
+
+	path := "iaas/api/projects/1"
+	var project map[string]any
+	response, err := client.R(t.Context(), path).SetResult(&project).Get(path)
+	CheckEqual(t, client.HandleAPIResponse(response, err, []int{200}), nil)
+	CheckEqual(t, project["id"], "1")
+	CheckEqual(t, project["password"], REDACTED)
+	CheckEqual(t, project["href"], CASSETTE_HOST+"/x")
+	id, err := client.GetIdFromLocation(response)
+	CheckEqual(t, err, nil)
+	CheckEqual(t, id, "1")
+
+	path = "icon/api/icons/1"
+	response, err = client.R(t.Context(), path).Get(path)
+	CheckEqual(t, client.HandleAPIResponse(response, err, []int{200}), nil)
+	CheckDeepEqual(t, response.Body(), testCassetteIcon)
+
+	path = "iaas/api/projects/2"
+	response, err = client.R(t.Context(), path).Get(path)
+	if err == nil || !strings.Contains(err.Error(), "has no recorded call for GET /"+path) {
+		t.Errorf("Expected missing call to be rejected, got error: %v", err)
+	}
+}
+
+func TestCassetteReplayOrder(t *testing.T) {
+	cassette := &Cassette{
+		Interactions: []CassetteInteraction{
+			{Method: "GET", URL: "/vco/api/workflows/1", StatusCode: 200},
+			{Method: "DELETE", URL: "/vco/api/workflows/1", StatusCode: 200},
+			{Method: "GET", URL: "/vco/api/workflows/1", StatusCode: 404},
+			{Method: "POST", URL: "/vco/api/workflows", RequestBody: "a", StatusCode: 201},
+			{Method: "POST", URL: "/vco/api/workflows", RequestBody: "b", StatusCode: 202},
+		},
+		byKey: map[string][]int{
+			"GET /vco/api/workflows/1": {0, 2},
+			"POST /vco/api/workflows":  {3, 4},
+		},
+		replayed: map[int]bool{},
+	}
+	for _, expected := range []int{200, 404, 404} {
+		interaction, found := cassette.Replay("GET /vco/api/workflows/1", "")
+		CheckEqual(t, found, true)
+		CheckEqual(t, interaction.StatusCode, expected)
+	}
+
+	// Concurrent requests are matched by body
+	interaction, _ := cassette.Replay("POST /vco/api/workflows", "b")
+	CheckEqual(t, interaction.StatusCode, 202)
+	interaction, _ = cassette.Replay("POST /vco/api/workflows", "a")
+	CheckEqual(t, interaction.StatusCode, 201)
+
+	_, found := cassette.Replay("GET /vco/api/workflows/2", "")
+	CheckEqual(t, found, false)
+}
+
+func TestCassetteInvalidMode(t *testing.T) {
+	client := AriaClient{
+		Host:         CASSETTE_HOST,
+		AccessToken:  "some-access-token",
+		CassetteMode: "rewind",
+		CassetteFile: "cassette.yaml",
+	}
+	CheckDiagnostics(
+		t, client.CheckConfig(), "", "Cassette mode rewind must be one of record, replay")
+}
+
+func TestCassetteReplayPollInterval(t *testing.T) {
+	client := AriaClient{CassetteMode: CASSETTE_MODE_RECORD}
+	CheckEqual(t, client.GetPollInterval(t.Context(), 30*time.Second), 30*time.Second)
+
+	// Recorded polls are replayed without waiting
+	client.CassetteMode = CASSETTE_MODE_REPLAY
+	CheckEqual(t, client.GetPollInterval(t.Context(), 30*time.Second), CASSETTE_POLL_INTERVAL)
+}
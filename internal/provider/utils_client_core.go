@@ -4,14 +4,17 @@
 package provider
 
 import (
+	"bytes"
 	"context"
-	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"net/url"
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -26,29 +29,83 @@ type AriaClient struct {
 	// Host must be a the URL to the base of the API.
 	Host string
 
+	// Authentication mode, one of AUTH_MODE_* (refresh token if empty).
+	AuthMode string
+
 	RefreshToken string `datapolicy:"token"`
 	AccessToken  string `datapolicy:"token"`
 
+	// Credentials for the password (CSP/vIDM) authentication mode.
+	Username string
+	Password string `datapolicy:"password"`
+	Domain   string
+
+	// Credentials for the OAuth client credentials authentication mode.
+	ClientId     string
+	ClientSecret string `datapolicy:"token"`
+
+	// Expiration of the access token, zero if unknown (e.g. access token given by configuration).
+	AccessTokenExpiresAt time.Time
+
 	OKAPICallsLogLevel string
 	KOAPICallsLogLevel string
 
+	// Fields redacted from the API calls logs (SENSITIVE_API_FIELDS if unset).
+	SensitiveFields SensitiveFields
+
+	// Maximum size of the bodies in the API calls logs (in bytes), zero means unlimited.
+	MaxLoggedBodySize int
+
+	// Path to a file where API calls are written (JSON Lines), disabled if empty.
+	APICallsLogFile string
+
 	// Transport Layer.
 	Insecure bool
 
+	// Additional CA certificates to trust (PEM encoded).
+	CACertPEM string
+
+	// Client certificate and key for mutual TLS (PEM encoded).
+	ClientCertPEM string
+	ClientKeyPEM  string `datapolicy:"security-key"`
+
+	// Retry policy for transient errors (exponential backoff with jitter).
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
 	// UserAgent is an optional field that specifies the caller of this request.
 	UserAgent string
 
+	// Proxy to send the requests through (proxy environment variables are used if empty).
+	ProxyURL string
+
+	// Maximum duration of a request (including reading the response), zero means no timeout.
+	RequestTimeout time.Duration
+
+	// Additional static headers sent with every request.
+	Headers map[string]string
+
+	// Limits applied to all API calls and per API (key is the first element of the path).
+	Limits    APILimits
+	APILimits map[string]APILimits
+
+	// Record or replay the API calls (one of CASSETTE_MODE_*, disabled if empty).
+	CassetteMode string
+	CassetteFile string
+
 	Context context.Context
 
 	Client *resty.Client
 
 	// Named read-write mutexes for managing resources
 	Mutex *RWMutexKV
-}
 
-type AccessTokenResponse struct {
-	TokenType string `json:"tokenType"`
-	Token     string `json:"token"`
+	// Serialize access token renewal across concurrent requests
+	tokenMutex *sync.Mutex
+
+	// Writer of the API calls log file (if enabled)
+	apiCallsLog *APICallsLogWriter
 }
 
 func (self *AriaClient) Init() diag.Diagnostics {
@@ -58,14 +115,60 @@ func (self *AriaClient) Init() diag.Diagnostics {
 		return diags
 	}
 
+	tlsConfig, err := self.GetTLSConfig()
+	if err != nil {
+		diags.AddError("Invalid TLS configuration", err.Error())
+		return diags
+	}
+
+	if self.SensitiveFields.Fields == nil {
+		self.SensitiveFields = GetSensitiveFields(self.Context, nil)
+	}
+
 	client := resty.New()
 	client.SetBaseURL(self.Host)
-	client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: self.Insecure})
-	if len(self.AccessToken) > 0 {
-		client.SetAuthToken(self.AccessToken)
-	}
+	client.SetTLSClientConfig(tlsConfig)
+	client.SetTimeout(self.RequestTimeout)
+	client.SetHeaders(self.Headers)
+	if len(self.UserAgent) > 0 {
+		client.SetHeader("User-Agent", self.UserAgent)
+	}
+	if len(self.ProxyURL) > 0 {
+		client.SetProxy(self.ProxyURL)
+	}
+	// Record or replay API calls (testing facility)
+	if len(self.CassetteMode) > 0 {
+		cassetteTransport, err := self.GetCassetteTransport(client.GetClient().Transport)
+		if err != nil {
+			diags.AddError("Unable to open cassette", err.Error())
+			return diags
+		}
+		client.SetTransport(cassetteTransport)
+	}
+	// Throttle API calls (must be the last change made to the transport), wrapping any transport
+	// (e.g. recording API calls)
+	client.SetTransport(self.GetLimitedTransport(client.GetClient().Transport))
+	// Identify requests, renew access token when necessary and retry requests failing with
+	// transient errors
+	client.OnBeforeRequest(SetRequestId)
+	client.OnBeforeRequest(self.AuthenticateRequest)
+	self.SetupRetryPolicy(client)
 	self.Client = client
 
+	if len(self.APICallsLogFile) > 0 {
+		apiCallsLog, err := OpenAPICallsLog(self.APICallsLogFile)
+		if err != nil {
+			diags.AddError(
+				"Configuration error",
+				fmt.Sprintf(
+					"Unable to open API calls log file %s, got error: %s",
+					self.APICallsLogFile, err))
+			return diags
+		}
+		self.apiCallsLog = apiCallsLog
+	}
+
+	self.tokenMutex = &sync.Mutex{}
 	diags.Append(self.GetAccessToken()...)
 
 	self.Mutex = NewRWMutexKV()
@@ -78,53 +181,61 @@ func (self *AriaClient) CheckConfig() diag.Diagnostics {
 	if len(self.Host) == 0 {
 		diags.AddError("Missing host", "Host is required to request the API")
 	}
-	if len(self.RefreshToken) == 0 && len(self.AccessToken) == 0 {
-		diags.AddError("Missing token", "Either refresh or access token is required")
+	if len(self.ProxyURL) > 0 {
+		if proxyURL, err := url.Parse(self.ProxyURL); err != nil || len(proxyURL.Host) == 0 {
+			diags.AddError(
+				"Invalid proxy URL",
+				fmt.Sprintf("Proxy URL %s must be an absolute URL (e.g. http://proxy:3128)",
+					self.ProxyURL))
+		}
 	}
-	return diags
-}
-
-func (self *AriaClient) GetAccessToken() diag.Diagnostics {
-	diags := diag.Diagnostics{}
-
-	// Refresh access token if refresh token is set and access token is empty
-	if len(self.RefreshToken) > 0 && len(self.AccessToken) == 0 {
-		self.Debug("Requesting a new API access token at %s", self.Host)
-
-		var token AccessTokenResponse
-		path := "iaas/api/login"
-		response, err := self.R(path).
-			SetHeader("Content-Type", "application/json").
-			SetBody(map[string]string{"refreshToken": self.RefreshToken}).
-			SetResult(&token).
-			Post(path)
-		err = self.HandleAPIResponse(response, err, []int{200})
-		if err != nil {
-			diags.AddError("Unable to retrieve a valid access token", err.Error())
-			return diags
+	if len(self.CassetteMode) > 0 {
+		if !slices.Contains(CASSETTE_MODES, self.CassetteMode) {
+			diags.AddError(
+				"Invalid cassette mode",
+				fmt.Sprintf("Cassette mode %s must be one of %s",
+					self.CassetteMode, strings.Join(CASSETTE_MODES, ", ")))
+		}
+		if len(self.CassetteFile) == 0 {
+			diags.AddError("Missing cassette file", "Cassette file is required to record or replay")
 		}
-
-		self.AccessToken = token.Token
 	}
-
-	if len(self.AccessToken) == 0 {
+	switch self.AuthMode {
+	case "", AUTH_MODE_REFRESH_TOKEN:
+		if len(self.RefreshToken) == 0 && len(self.AccessToken) == 0 {
+			diags.AddError("Missing token", "Either refresh or access token is required")
+		}
+	case AUTH_MODE_PASSWORD:
+		if len(self.Username) == 0 || len(self.Password) == 0 {
+			diags.AddError(
+				"Missing credentials", "Username and password are required to login with password")
+		}
+	case AUTH_MODE_CLIENT_CREDENTIALS:
+		if len(self.ClientId) == 0 || len(self.ClientSecret) == 0 {
+			diags.AddError(
+				"Missing credentials",
+				"Client ID and secret are required to login with client credentials")
+		}
+	default:
 		diags.AddError(
-			"Empty Access Token",
-			"Access Token is empty, will be unable to make API calls")
+			"Invalid authentication mode",
+			fmt.Sprintf("Authentication mode %s is not supported", self.AuthMode))
 	}
-
 	return diags
 }
 
 // Return a new request insance with apiVersion header set, based on path.
-func (self AriaClient) R(path string) *resty.Request {
+// The request is bound to the context of the operation (to be interrupted when cancelled).
+func (self *AriaClient) R(ctx context.Context, path string) *resty.Request {
+	request := self.Client.R().SetContext(ctx)
 	if version := self.GetVersionFromPath(path); len(version) > 0 {
-		return self.Client.R().SetQueryParam("apiVersion", version)
+		return request.SetQueryParam("apiVersion", version)
 	}
-	return self.Client.R()
+	return request
 }
 
-func (self AriaClient) ReadIt(
+func (self *AriaClient) ReadIt(
+	ctx context.Context,
 	instance Model,
 	instanceRaw APIModel,
 	readPath ...string,
@@ -149,7 +260,8 @@ func (self AriaClient) ReadIt(
 		return false, nil, diags
 	}
 
-	response, err := self.R(path).SetResult(&instanceRaw).Get(path)
+	ctx = WithAPICallsResource(ctx, instance.String())
+	response, err := self.R(ctx, path).SetResult(&instanceRaw).Get(path)
 	if response.StatusCode() == 404 {
 		self.Debug("%s not found", instance.String())
 		return false, response, diags
@@ -165,7 +277,8 @@ func (self AriaClient) ReadIt(
 	return true, response, diags
 }
 
-func (self AriaClient) DeleteIt(
+func (self *AriaClient) DeleteIt(
+	ctx context.Context,
 	instance Model,
 	conflictMaxAttemptsOptional ...int,
 ) diag.Diagnostics {
@@ -177,19 +290,24 @@ func (self AriaClient) DeleteIt(
 
 	diags := diag.Diagnostics{}
 	name := instance.String()
+	ctx = WithAPICallsResource(ctx, name)
 	self.Debug("Deleting %s...", name)
 
 	for attempt := 0; attempt <= conflictMaxAttempts; attempt++ {
 
 		// Delete the resource
 		deletePath := instance.DeletePath()
-		response, err := self.R(deletePath).Delete(deletePath)
+		response, err := self.R(ctx, deletePath).Delete(deletePath)
 		err = self.HandleAPIResponse(response, err, []int{200, 204})
 		if err != nil {
 			// This is potentially an error that will be solved by the deletion of other resources.
 			// We can retry the delete operation after some time to converge to desired state.
 			if attempt < conflictMaxAttempts && response.StatusCode() == 409 {
-				time.Sleep(time.Duration(3) * time.Second) // TODO better with randomness?
+				if err := Sleep(ctx, self.GetBackoff(attempt)); err != nil {
+					diags.Append(
+						InterruptedDiagnostics(err, fmt.Sprintf("trying to delete %s", name))...)
+					return diags
+				}
 				continue
 			}
 			// Either its not a conflict error either we have made sufficient attempts...
@@ -206,11 +324,21 @@ func (self AriaClient) DeleteIt(
 			return diags
 		}
 
-		for retry := range []int{0, 1, 2, 3, 4} {
-			time.Sleep(time.Duration(retry) * time.Second)
-			self.Debug("Poll %d of 5 - Check %s is deleted...", retry+1, name)
+		// Poll until the deadline of the operation (see timeouts) if any, else a few times
+		for poll := 0; ; poll++ {
+			if _, hasDeadline := ctx.Deadline(); !hasDeadline && poll >= DELETE_POLL_COUNT {
+				break
+			}
+			interval := min(
+				time.Duration(poll)*time.Second, self.GetPollInterval(ctx, 10*time.Second))
+			if err := Sleep(ctx, interval); err != nil {
+				diags.Append(
+					InterruptedDiagnostics(err, fmt.Sprintf("waiting for %s to be deleted", name))...)
+				return diags
+			}
+			self.Debug("Poll %d - Check %s is deleted...", poll+1, name)
 
-			response, err := self.R(readPath).Get(readPath)
+			response, err := self.R(ctx, readPath).Get(readPath)
 			err = self.HandleAPIResponse(response, err, []int{200, 404})
 			if err != nil {
 				diags.AddError(
@@ -230,7 +358,7 @@ func (self AriaClient) DeleteIt(
 	return diags
 }
 
-func (self AriaClient) HandleAPIResponse(
+func (self *AriaClient) HandleAPIResponse(
 	response *resty.Response,
 	err error,
 	statusCodes []int,
@@ -244,6 +372,9 @@ func (self AriaClient) HandleAPIResponse(
 	statusCodesText := strings.Join(statusCodesString, ", ")
 
 	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			err = fmt.Errorf("API call interrupted, operation was cancelled or timed out: %w", err)
+		}
 		self.LogAPIResponseInfo(response, err, statusCodesText)
 		return err
 	}
@@ -253,36 +384,60 @@ func (self AriaClient) HandleAPIResponse(
 			"API response status code %d (expected %s), Body: %s",
 			response.StatusCode(),
 			statusCodesText,
-			response.String())
+			self.GetRedactedBody(response))
 	}
 
 	self.LogAPIResponseInfo(response, err, statusCodesText)
 	return err
 }
 
-func (self AriaClient) LogAPIResponseInfo(
+func (self *AriaClient) LogAPIResponseInfo(
 	response *resty.Response,
 	err error,
 	statusCodesText string,
 ) {
 	request := response.Request
-	requestBody, requestBodyErr := json.MarshalIndent(request.Body, "", "\t")
+	path := request.URL
+	requestHeaders := request.Header
+	if request.RawRequest != nil {
+		path = request.RawRequest.URL.Path
+		requestHeaders = request.RawRequest.Header
+	}
+
+	requestBody, requestBodyErr := json.Marshal(request.Body)
 	if requestBodyErr != nil {
 		requestBody = []byte("<body>")
+	} else {
+		requestBody = self.SensitiveFields.RedactBody(path, requestBody)
 	}
 
 	var responseBody []byte
 	if strings.Contains(request.URL, "icon/api/icons") && request.Method == "GET" {
 		responseBody = []byte("<THE ICON>")
 	} else {
-		var responseData any
-		responseBody = response.Body()
-		if json.Unmarshal(responseBody, &responseData) == nil {
-			body, bodyErr := json.MarshalIndent(responseData, "", "\t")
-			if bodyErr == nil {
-				responseBody = body
-			}
-		}
+		responseBody = self.SensitiveFields.RedactBody(path, response.Body())
+	}
+
+	// The values of the headers set by configuration may be credentials (e.g. an API key)
+	sensitiveHeaders := slices.Collect(maps.Keys(self.Headers))
+
+	call := APICallLog{
+		Time:            response.ReceivedAt(),
+		RequestId:       request.Header.Get(REQUEST_ID_HEADER),
+		Method:          request.Method,
+		URL:             request.URL,
+		Path:            path,
+		Attempt:         request.Attempt,
+		RequestHeaders:  RedactHeaders(requestHeaders, sensitiveHeaders...),
+		RequestBody:     string(TruncateBody(requestBody, self.MaxLoggedBodySize)),
+		StatusCode:      response.StatusCode(),
+		Expected:        statusCodesText,
+		Duration:        response.Time().Milliseconds(),
+		ResponseHeaders: RedactHeaders(response.Header(), sensitiveHeaders...),
+		ResponseBody:    string(TruncateBody(responseBody, self.MaxLoggedBodySize)),
+	}
+	if err != nil {
+		call.Error = err.Error()
 	}
 
 	level := self.OKAPICallsLogLevel
@@ -290,25 +445,25 @@ func (self AriaClient) LogAPIResponseInfo(
 		level = self.KOAPICallsLogLevel
 	}
 
-	self.Log(level, "%s", strings.Join([]string{
-		"",
-		"Request Info:",
-		fmt.Sprintf("  URL         : %s", request.URL),
-		fmt.Sprintf("  Method      : %s", request.Method),
-		fmt.Sprintf("  Body        : %s", requestBody),
-		"Response Info:",
-		fmt.Sprintf("  Error       : %s", err),
-		fmt.Sprintf("  Status Code : %d", response.StatusCode()),
-		fmt.Sprintf("  Expected    : %s", statusCodesText),
-		fmt.Sprintf("  Status      : %s", response.Status()),
-		fmt.Sprintf("  Proto       : %s", response.Proto()),
-		fmt.Sprintf("  Time        : %s", response.Time()),
-		fmt.Sprintf("  Received At : %s", response.ReceivedAt()),
-		fmt.Sprintf("  Body        : %s", responseBody),
-	}, "\n"))
+	self.LogAPICall(request.Context(), level, call)
+}
+
+// Return the body of the response with sensitive fields redacted, compacted (if JSON) and
+// truncated to MaxLoggedBodySize (e.g. for reporting errors).
+func (self *AriaClient) GetRedactedBody(response *resty.Response) string {
+	path := response.Request.URL
+	if response.Request.RawRequest != nil {
+		path = response.Request.RawRequest.URL.Path
+	}
+	body := self.SensitiveFields.RedactBody(path, response.Body())
+	compacted := bytes.Buffer{}
+	if json.Compact(&compacted, body) == nil {
+		body = compacted.Bytes()
+	}
+	return string(TruncateBody(body, self.MaxLoggedBodySize))
 }
 
-func (self AriaClient) GetIdFromLocation(response *resty.Response) (string, error) {
+func (self *AriaClient) GetIdFromLocation(response *resty.Response) (string, error) {
 	location, err := url.Parse(response.Header().Get("Location"))
 	if err != nil {
 		return "", err
@@ -318,7 +473,7 @@ func (self AriaClient) GetIdFromLocation(response *resty.Response) (string, erro
 	return parts[len(parts)-1], nil
 }
 
-func (self AriaClient) GetVersionFromPath(path string) string {
+func (self *AriaClient) GetVersionFromPath(path string) string {
 	// TODO Take first element of path before /, then map it (faster)
 	if strings.HasPrefix(path, "abx") {
 		return ABX_API_VERSION
@@ -329,6 +484,12 @@ func (self AriaClient) GetVersionFromPath(path string) string {
 	if strings.HasPrefix(path, "catalog") {
 		return CATALOG_API_VERSION
 	}
+	if strings.HasPrefix(path, "csp") {
+		return CSP_API_VERSION
+	}
+	if strings.HasPrefix(path, "deployment") {
+		return DEPLOYMENT_API_VERSION
+	}
 	if strings.HasPrefix(path, "event-broker") {
 		return EVENT_BROKER_API_VERSION
 	}
@@ -0,0 +1,89 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Return an Aria client talking to given fake API (unless the client's host is set).
+func NewTestClient(t *testing.T, client AriaClient, handler http.HandlerFunc) *AriaClient {
+	if len(client.Host) == 0 {
+		server := httptest.NewServer(handler)
+		t.Cleanup(server.Close)
+		client.Host = server.URL
+	}
+	client.AccessToken = "some-access-token"
+	client.Context = t.Context()
+	client.OKAPICallsLogLevel = "TRACE"
+	client.KOAPICallsLogLevel = "TRACE"
+	CheckDiagnostics(t, client.Init(), "", "")
+	return &client
+}
+
+func TestAriaClientHeaders(t *testing.T) {
+	var userAgent, team string
+	client := NewTestClient(t, AriaClient{
+		UserAgent: "terraform-provider-aria/test",
+		Headers:   map[string]string{"X-Team": "platform"},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		team = r.Header.Get("X-Team")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	})
+
+	response, err := client.R(t.Context(), "iaas/api/projects").Get("iaas/api/projects")
+	CheckEqual(t, client.HandleAPIResponse(response, err, []int{200}), nil)
+	CheckEqual(t, userAgent, "terraform-provider-aria/test")
+	CheckEqual(t, team, "platform")
+}
+
+func TestAriaClientProxy(t *testing.T) {
+	var proxied string
+	proxy := NewTestClient(t, AriaClient{}, func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	})
+
+	client := NewTestClient(t, AriaClient{
+		Host:     "http://aria.local",
+		ProxyURL: proxy.Host,
+	}, nil)
+
+	response, err := client.R(t.Context(), "iaas/api/projects").Get("iaas/api/projects")
+	CheckEqual(t, client.HandleAPIResponse(response, err, []int{200}), nil)
+	if !strings.HasPrefix(proxied, "http://aria.local/iaas/api/projects") {
+		t.Errorf("Request to %s has not been sent through the proxy", proxied)
+	}
+}
+
+func TestAriaClientRequestTimeout(t *testing.T) {
+	client := NewTestClient(t, AriaClient{
+		RequestTimeout: 50 * time.Millisecond,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+
+	response, err := client.R(t.Context(), "iaas/api/projects").Get("iaas/api/projects")
+	if client.HandleAPIResponse(response, err, []int{200}) == nil {
+		t.Errorf("Request should have timed out")
+	}
+}
+
+func TestAriaClientCheckConfigProxyURL(t *testing.T) {
+	client := AriaClient{Host: "https://aria.local", AccessToken: "token", ProxyURL: "proxy:3128"}
+	CheckDiagnostics(
+		t, client.CheckConfig(), "",
+		"Proxy URL proxy:3128 must be an absolute URL (e.g. http://proxy:3128)")
+}
@@ -0,0 +1,154 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// APIs whose calls can be limited, by first element of their path (see GetVersionFromPath).
+var LIMITED_APIS = []string{
+	"abx", "blueprint", "catalog", "csp", "deployment", "event-broker", "form-service", "iaas",
+	"icon", "platform", "policy", "project-service", "properties", "vco", "vro",
+}
+
+// Limits applied to API calls, zero means unlimited.
+type APILimits struct {
+	MaxConcurrentRequests int
+	RequestsPerSecond     float64
+}
+
+// Limit the number of concurrent requests and the rate of requests.
+type RequestLimiter struct {
+	concurrency chan struct{}
+	rate        *rate.Limiter
+}
+
+// Return a limiter enforcing given limits, nil if unlimited.
+func NewRequestLimiter(limits APILimits) *RequestLimiter {
+	if limits.MaxConcurrentRequests <= 0 && limits.RequestsPerSecond <= 0 {
+		return nil
+	}
+	limiter := &RequestLimiter{}
+	if limits.MaxConcurrentRequests > 0 {
+		limiter.concurrency = make(chan struct{}, limits.MaxConcurrentRequests)
+	}
+	if limits.RequestsPerSecond > 0 {
+		burst := max(int(math.Ceil(limits.RequestsPerSecond)), 1)
+		limiter.rate = rate.NewLimiter(rate.Limit(limits.RequestsPerSecond), burst)
+	}
+	return limiter
+}
+
+// Wait until a request can be made (or the context is done). Release must be called after the
+// request is made if no error is returned.
+func (self *RequestLimiter) Acquire(ctx context.Context) error {
+	if self.concurrency != nil {
+		select {
+		case self.concurrency <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if self.rate != nil {
+		if err := self.rate.Wait(ctx); err != nil {
+			self.Release()
+			return err
+		}
+	}
+	return nil
+}
+
+// Release the slot taken by a request.
+func (self *RequestLimiter) Release() {
+	if self.concurrency != nil {
+		<-self.concurrency
+	}
+}
+
+// HTTP transport applying limiters to the requests (global and per API).
+// The slot of a request is released when its response's body is closed.
+type LimitedTransport struct {
+	Base http.RoundTripper
+
+	// Limiter applied to all requests (may be nil).
+	Limiter *RequestLimiter
+
+	// Limiters applied per API, key is the first element of the path (e.g. vco, catalog).
+	APILimiters map[string]*RequestLimiter
+}
+
+func (self *LimitedTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	limiters := []*RequestLimiter{}
+	if self.Limiter != nil {
+		limiters = append(limiters, self.Limiter)
+	}
+	if limiter := self.APILimiters[GetAPIFromPath(request.URL.Path)]; limiter != nil {
+		limiters = append(limiters, limiter)
+	}
+
+	release := func(count int) {
+		for _, limiter := range limiters[:count] {
+			limiter.Release()
+		}
+	}
+	for index, limiter := range limiters {
+		if err := limiter.Acquire(request.Context()); err != nil {
+			release(index)
+			return nil, err
+		}
+	}
+
+	response, err := self.Base.RoundTrip(request)
+	if err != nil {
+		release(len(limiters))
+		return nil, err
+	}
+	if len(limiters) > 0 {
+		response.Body = &releaseOnClose{ReadCloser: response.Body, release: func() {
+			release(len(limiters))
+		}}
+	}
+	return response, nil
+}
+
+// Return a transport applying the limits of the client to the requests.
+func (self *AriaClient) GetLimitedTransport(base http.RoundTripper) http.RoundTripper {
+	apiLimiters := map[string]*RequestLimiter{}
+	for api, limits := range self.APILimits {
+		if limiter := NewRequestLimiter(limits); limiter != nil {
+			apiLimiters[api] = limiter
+		}
+	}
+	limiter := NewRequestLimiter(self.Limits)
+	if limiter == nil && len(apiLimiters) == 0 {
+		return base
+	}
+	return &LimitedTransport{Base: base, Limiter: limiter, APILimiters: apiLimiters}
+}
+
+// Return the API of the path (its first element, e.g. vco for /vco/api/workflows).
+func GetAPIFromPath(path string) string {
+	api, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	return api
+}
+
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (self *releaseOnClose) Close() error {
+	err := self.ReadCloser.Close()
+	self.once.Do(self.release)
+	return err
+}
@@ -0,0 +1,105 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Return an Aria client with given limits and the maximum number of concurrent calls it made.
+func NewTestLimitedClient(t *testing.T, client AriaClient) (*AriaClient, *atomic.Int32) {
+	var inFlight, maxInFlight atomic.Int32
+	return NewTestClient(t, client, func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			previous := maxInFlight.Load()
+			if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	}), &maxInFlight
+}
+
+// Make concurrent calls to given path.
+func MakeConcurrentCalls(t *testing.T, client *AriaClient, path string, count int) {
+	var wg sync.WaitGroup
+	for range count {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			response, err := client.R(t.Context(), path).Get(path)
+			CheckEqual(t, client.HandleAPIResponse(response, err, []int{200}), nil)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestAriaClientMaxConcurrentRequests(t *testing.T) {
+	client, maxInFlight := NewTestLimitedClient(t, AriaClient{
+		Limits: APILimits{MaxConcurrentRequests: 2},
+	})
+	MakeConcurrentCalls(t, client, "iaas/api/projects", 10)
+	if maxInFlight.Load() > 2 {
+		t.Errorf("Expected at most 2 concurrent calls, got %d", maxInFlight.Load())
+	}
+}
+
+func TestAriaClientAPILimits(t *testing.T) {
+	client, maxInFlight := NewTestLimitedClient(t, AriaClient{
+		APILimits: map[string]APILimits{"vco": {MaxConcurrentRequests: 1}},
+	})
+	MakeConcurrentCalls(t, client, "vco/api/workflows", 5)
+	CheckEqual(t, maxInFlight.Load(), int32(1))
+}
+
+func TestAriaClientAPILimitsRecording(t *testing.T) {
+	// Limits are applied whatever the transport (e.g. recording API calls)
+	client, maxInFlight := NewTestLimitedClient(t, AriaClient{
+		APILimits:    map[string]APILimits{"vco": {MaxConcurrentRequests: 1}},
+		CassetteMode: CASSETTE_MODE_RECORD,
+		CassetteFile: filepath.Join(t.TempDir(), "cassette.yaml"),
+	})
+	t.Cleanup(func() { CloseCassette(client.CassetteFile) })
+	_, isLimited := client.Client.GetClient().Transport.(*LimitedTransport)
+	CheckEqual(t, isLimited, true)
+	MakeConcurrentCalls(t, client, "vco/api/workflows", 5)
+	CheckEqual(t, maxInFlight.Load(), int32(1))
+}
+
+func TestAriaClientRequestsPerSecond(t *testing.T) {
+	client, _ := NewTestLimitedClient(t, AriaClient{
+		Limits: APILimits{RequestsPerSecond: 20},
+	})
+	start := time.Now()
+	MakeConcurrentCalls(t, client, "iaas/api/projects", 30)
+	// Burst of 20 calls then 10 calls at 20 per second
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("Expected calls to be throttled, took %s", elapsed)
+	}
+}
+
+func TestGetAPIFromPath(t *testing.T) {
+	CheckEqual(t, GetAPIFromPath("/vco/api/workflows"), "vco")
+	CheckEqual(t, GetAPIFromPath("catalog/api/items"), "catalog")
+	CheckEqual(t, GetAPIFromPath("/"), "")
+}
+
+func TestLimitedAPIs(t *testing.T) {
+	// Every API that can be limited is known (GetVersionFromPath is panicking otherwise)
+	client := AriaClient{}
+	for _, api := range LIMITED_APIS {
+		CheckEqual(t, GetAPIFromPath(api+"/api/some-items"), api)
+		client.GetVersionFromPath(api + "/api/some-items")
+	}
+}
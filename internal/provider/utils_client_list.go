@@ -0,0 +1,201 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// How the instances of a collection are paginated by the API.
+const LIST_PAGINATION_PAGE = "page"   // page & size, e.g. project-service, abx, catalog
+const LIST_PAGINATION_ODATA = "odata" // $skip & $top, e.g. iaas
+const LIST_PAGINATION_VRO = "vro"     // startIndex & maxResult, e.g. vco
+
+// Number of instances retrieved per API call.
+const LIST_PAGE_SIZE = 100
+
+// Maximum number of API calls made to retrieve all instances (prevent looping forever).
+const LIST_MAX_PAGES = 1000
+
+// ListQuery describes how to retrieve all instances of a collection matching a filter.
+type ListQuery struct {
+	Path       string
+	Pagination string
+
+	// OData filter, sent as $filter (or as conditions for vRO APIs)
+	Filter string
+
+	// Additional query parameters
+	Params map[string]string
+
+	// Defaults to LIST_PAGE_SIZE
+	PageSize int
+}
+
+// Page of instances as returned by most APIs (page & size or $skip & $top).
+type PageAPIModel[T any] struct {
+	Content          []T  `json:"content"`
+	TotalElements    int  `json:"totalElements"`
+	NumberOfElements int  `json:"numberOfElements"`
+	TotalPages       int  `json:"totalPages"`
+	Last             bool `json:"last"`
+}
+
+// Page of instances as returned by vRO APIs (instances are links with attributes).
+type VROPageAPIModel struct {
+	Link []struct {
+		Attributes []struct {
+			Name  string `json:"name"`
+			Value any    `json:"value"`
+		} `json:"attributes"`
+		Href string `json:"href"`
+	} `json:"link"`
+	Total int `json:"total"`
+}
+
+func (self ListQuery) String() string {
+	if len(self.Filter) == 0 {
+		return self.Path
+	}
+	return fmt.Sprintf("%s matching %q", self.Path, self.Filter)
+}
+
+// Retrieve the instances of the collection, all pages.
+func ListAll[T any](ctx context.Context, client *AriaClient, query ListQuery) ([]T, error) {
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = LIST_PAGE_SIZE
+	}
+
+	instances := []T{}
+	for page := 0; page < LIST_MAX_PAGES; page++ {
+		request := client.R(ctx, query.Path).SetQueryParams(query.Params)
+		switch query.Pagination {
+		case LIST_PAGINATION_PAGE:
+			request.SetQueryParam("page", strconv.Itoa(page))
+			request.SetQueryParam("size", strconv.Itoa(pageSize))
+		case LIST_PAGINATION_ODATA:
+			request.SetQueryParam("$skip", strconv.Itoa(len(instances)))
+			request.SetQueryParam("$top", strconv.Itoa(pageSize))
+		case LIST_PAGINATION_VRO:
+			request.SetQueryParam("startIndex", strconv.Itoa(len(instances)))
+			request.SetQueryParam("maxResult", strconv.Itoa(pageSize))
+		default:
+			return nil, fmt.Errorf("pagination %q is not supported", query.Pagination)
+		}
+		if len(query.Filter) > 0 {
+			if query.Pagination == LIST_PAGINATION_VRO {
+				request.SetQueryParam("conditions", query.Filter)
+			} else {
+				request.SetQueryParam("$filter", query.Filter)
+			}
+		}
+
+		response, err := request.Get(query.Path)
+		err = client.HandleAPIResponse(response, err, []int{200})
+		if err != nil {
+			return nil, err
+		}
+
+		var content []T
+		var total int
+		var last bool
+		if query.Pagination == LIST_PAGINATION_VRO {
+			content, total, err = DecodeVROPage[T](response.Body())
+		} else {
+			var pageRaw PageAPIModel[T]
+			err = json.Unmarshal(response.Body(), &pageRaw)
+			content, total = pageRaw.Content, pageRaw.TotalElements
+			last = pageRaw.Last || (pageRaw.TotalPages > 0 && page+1 >= pageRaw.TotalPages)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode page %d of %s: %w", page, query.String(), err)
+		}
+
+		// Do not rely on a single indicator, some APIs are not returning all of them
+		// (the total is preferred, the API may return less instances than the page size)
+		instances = append(instances, content...)
+		if len(content) == 0 || last {
+			return instances, nil
+		}
+		if total > 0 && len(instances) >= total || total == 0 && len(content) < pageSize {
+			return instances, nil
+		}
+	}
+	return nil, fmt.Errorf("%s has more than %d pages", query.String(), LIST_MAX_PAGES)
+}
+
+// Retrieve the instances of the collection then return the only one matching (an error is returned
+// if none or several instances are matching).
+func FindOne[T any](
+	ctx context.Context,
+	client *AriaClient,
+	query ListQuery,
+	match func(instance T) bool,
+) (T, error) {
+	var found T
+	instances, err := ListAll[T](ctx, client, query)
+	if err != nil {
+		return found, err
+	}
+	matching := slices.DeleteFunc(instances, func(instance T) bool { return !match(instance) })
+	switch len(matching) {
+	case 0:
+		return found, fmt.Errorf("no instance of %s", query.String())
+	case 1:
+		return matching[0], nil
+	default:
+		return found, fmt.Errorf(
+			"%d instances of %s, expected exactly one", len(matching), query.String())
+	}
+}
+
+// Convert the links of a vRO page to instances (attributes are mapped by name to JSON fields).
+func DecodeVROPage[T any](body []byte) ([]T, int, error) {
+	var pageRaw VROPageAPIModel
+	if err := json.Unmarshal(body, &pageRaw); err != nil {
+		return nil, 0, err
+	}
+	content := make([]T, 0, len(pageRaw.Link))
+	for _, link := range pageRaw.Link {
+		attributes := map[string]any{"href": link.Href}
+		for _, attribute := range link.Attributes {
+			attributes[attribute.Name] = attribute.Value
+		}
+		data, err := json.Marshal(attributes)
+		if err != nil {
+			return nil, 0, err
+		}
+		var instance T
+		if err := json.Unmarshal(data, &instance); err != nil {
+			return nil, 0, err
+		}
+		content = append(content, instance)
+	}
+	return content, pageRaw.Total, nil
+}
+
+// Return the value as an OData string literal (quoted, with its quotes escaped).
+func ODataString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// Return true if search is empty or contained in one of the values (case insensitive).
+func MatchSearch(search string, values ...string) bool {
+	if len(search) == 0 {
+		return true
+	}
+	search = strings.ToLower(search)
+	for _, value := range values {
+		if strings.Contains(strings.ToLower(value), search) {
+			return true
+		}
+	}
+	return false
+}
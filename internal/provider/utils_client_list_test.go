@@ -0,0 +1,148 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type ListTestAPIModel struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Return an Aria client talking to a fake API returning total instances, at most maxSize per page.
+func NewTestListClient(t *testing.T, total int, maxSize int) (*AriaClient, *atomic.Int32) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		query := r.URL.Query()
+		var start, size int
+		switch {
+		case query.Has("page"):
+			page, _ := strconv.Atoi(query.Get("page"))
+			size, _ = strconv.Atoi(query.Get("size"))
+			start = page * size
+		case query.Has("$top"):
+			start, _ = strconv.Atoi(query.Get("$skip"))
+			size, _ = strconv.Atoi(query.Get("$top"))
+		default:
+			start, _ = strconv.Atoi(query.Get("startIndex"))
+			size, _ = strconv.Atoi(query.Get("maxResult"))
+		}
+		size = min(size, maxSize)
+
+		content := []map[string]any{}
+		links := []map[string]any{}
+		for index := start; index < min(start+size, total); index++ {
+			id := fmt.Sprintf("%s-%d", query.Get("$filter")+query.Get("conditions"), index)
+			content = append(content, map[string]any{"id": id, "name": "Name " + id})
+			links = append(links, map[string]any{"attributes": []map[string]any{
+				{"name": "id", "value": id},
+				{"name": "name", "value": "Name " + id},
+			}})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"content":       content,
+			"totalElements": total,
+			"link":          links,
+			"total":         total,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	client := AriaClient{
+		Host:               server.URL,
+		AccessToken:        "some-access-token",
+		MaxRetries:         0,
+		MinBackoff:         time.Millisecond,
+		MaxBackoff:         10 * time.Millisecond,
+		Context:            t.Context(),
+		OKAPICallsLogLevel: "TRACE",
+		KOAPICallsLogLevel: "TRACE",
+	}
+	CheckDiagnostics(t, client.Init(), "", "")
+	return &client, &calls
+}
+
+func TestListAll(t *testing.T) {
+	cases := []struct {
+		name       string
+		pagination string
+		total      int
+		maxSize    int
+		calls      int32
+	}{
+		{"Page", LIST_PAGINATION_PAGE, 7, 100, 3},
+		{"OData", LIST_PAGINATION_ODATA, 7, 100, 3},
+		{"OData capped by the API", LIST_PAGINATION_ODATA, 7, 2, 4},
+		{"vRO", LIST_PAGINATION_VRO, 7, 100, 3},
+		{"Empty", LIST_PAGINATION_PAGE, 0, 100, 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, calls := NewTestListClient(t, tc.total, tc.maxSize)
+			instances, err := ListAll[ListTestAPIModel](t.Context(), client, ListQuery{
+				Path:       "iaas/api/instances",
+				Pagination: tc.pagination,
+				Filter:     "f",
+				PageSize:   3,
+			})
+			CheckEqual(t, err, nil)
+			CheckEqual(t, len(instances), tc.total)
+			CheckEqual(t, calls.Load(), tc.calls)
+			for index, instance := range instances {
+				CheckEqual(t, instance.Id, fmt.Sprintf("f-%d", index))
+				CheckEqual(t, instance.Name, fmt.Sprintf("Name f-%d", index))
+			}
+		})
+	}
+}
+
+func TestListAllUnsupportedPagination(t *testing.T) {
+	client, calls := NewTestListClient(t, 1, 100)
+	_, err := ListAll[ListTestAPIModel](t.Context(), client, ListQuery{Path: "iaas/api/instances"})
+	CheckEqual(t, fmt.Sprint(err), `pagination "" is not supported`)
+	CheckEqual(t, calls.Load(), int32(0))
+}
+
+func TestFindOne(t *testing.T) {
+	client, _ := NewTestListClient(t, 3, 100)
+	query := ListQuery{Path: "iaas/api/instances", Pagination: LIST_PAGINATION_ODATA, Filter: "f"}
+	byName := func(name string) func(ListTestAPIModel) bool {
+		return func(instance ListTestAPIModel) bool { return instance.Name == name }
+	}
+
+	instance, err := FindOne(t.Context(), client, query, byName("Name f-1"))
+	CheckEqual(t, err, nil)
+	CheckEqual(t, instance.Id, "f-1")
+
+	_, err = FindOne(t.Context(), client, query, byName("Name f-3"))
+	CheckEqual(t, fmt.Sprint(err), `no instance of iaas/api/instances matching "f"`)
+
+	_, err = FindOne(t.Context(), client, query, func(ListTestAPIModel) bool { return true })
+	CheckEqual(t, fmt.Sprint(err),
+		`3 instances of iaas/api/instances matching "f", expected exactly one`)
+}
+
+func TestODataString(t *testing.T) {
+	CheckEqual(t, ODataString("foo"), "'foo'")
+	CheckEqual(t, ODataString("it's"), "'it''s'")
+}
+
+func TestMatchSearch(t *testing.T) {
+	CheckEqual(t, MatchSearch("", "anything"), true)
+	CheckEqual(t, MatchSearch("foo", "Some FOO bar"), true)
+	CheckEqual(t, MatchSearch("foo", "bar", "foobar"), true)
+	CheckEqual(t, MatchSearch("foo", "bar"), false)
+	CheckEqual(t, MatchSearch("foo"), false)
+}
@@ -4,32 +4,166 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"sync"
+	"time"
 
+	"github.com/go-resty/resty/v2"
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func (self AriaClient) Error(message string, args ...any) {
+// Header identifying a request, to match API calls with the logs of the Aria appliance.
+const REQUEST_ID_HEADER = "X-Request-Id"
+
+// An API call (request and response), logged with structured fields and in the API calls log file.
+type APICallLog struct {
+	Time            time.Time `json:"time"`
+	RequestId       string    `json:"request_id"`
+	Resource        string    `json:"resource,omitempty"`
+	Method          string    `json:"method"`
+	URL             string    `json:"url"`
+	Path            string    `json:"path"`
+	Attempt         int       `json:"attempt"`
+	RequestHeaders  string    `json:"request_headers"`
+	RequestBody     string    `json:"request_body"`
+	StatusCode      int       `json:"status_code"`
+	Expected        string    `json:"expected"`
+	Error           string    `json:"error,omitempty"`
+	Duration        int64     `json:"duration_ms"`
+	ResponseHeaders string    `json:"response_headers"`
+	ResponseBody    string    `json:"response_body"`
+}
+
+// Return the fields of the API call, for structured logging.
+func (self APICallLog) Fields() map[string]any {
+	return map[string]any{
+		"aria_request_id":       self.RequestId,
+		"aria_resource":         self.Resource,
+		"aria_method":           self.Method,
+		"aria_path":             self.Path,
+		"aria_attempt":          self.Attempt,
+		"aria_request_headers":  self.RequestHeaders,
+		"aria_request_body":     self.RequestBody,
+		"aria_status_code":      self.StatusCode,
+		"aria_expected":         self.Expected,
+		"aria_error":            self.Error,
+		"aria_duration_ms":      self.Duration,
+		"aria_response_headers": self.ResponseHeaders,
+		"aria_response_body":    self.ResponseBody,
+	}
+}
+
+// Write API calls to a file, as JSON Lines. Safe for concurrent use.
+type APICallsLogWriter struct {
+	mutex sync.Mutex
+	path  string
+}
+
+// Check the API calls log file can be opened (in append mode, created if missing).
+// The file is opened for every call written then closed (there is no hook to close it when the
+// provider is stopped).
+func OpenAPICallsLog(path string) (*APICallsLogWriter, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+	return &APICallsLogWriter{path: path}, nil
+}
+
+func (self *APICallsLogWriter) Write(call APICallLog) error {
+	line, err := json.Marshal(call)
+	if err != nil {
+		return err
+	}
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	file, err := os.OpenFile(self.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(append(line, '\n'))
+	return errors.Join(err, file.Close())
+}
+
+// Return the context with the resource the API calls are made for (to be logged along the calls).
+func WithAPICallsResource(ctx context.Context, resource string) context.Context {
+	return context.WithValue(ctx, apiCallsResourceKey{}, resource)
+}
+
+type apiCallsResourceKey struct{}
+
+// Set a generated request ID header on the request, kept across retries.
+// Registered as a request middleware of the resty client.
+func SetRequestId(client *resty.Client, request *resty.Request) error {
+	if len(request.Header.Get(REQUEST_ID_HEADER)) == 0 {
+		request.SetHeader(REQUEST_ID_HEADER, uuid.NewString())
+	}
+	return nil
+}
+
+// Log the API call with structured fields, and write it to the API calls log file if enabled.
+func (self *AriaClient) LogAPICall(ctx context.Context, level string, call APICallLog) {
+	if resource, ok := ctx.Value(apiCallsResourceKey{}).(string); ok {
+		call.Resource = resource
+	}
+
+	message := fmt.Sprintf(
+		"API call %s %s returned %d in %dms", call.Method, call.Path, call.StatusCode, call.Duration)
+	if len(call.Error) > 0 {
+		message = fmt.Sprintf("API call %s %s failed: %s", call.Method, call.Path, call.Error)
+	}
+
+	fields := call.Fields()
+	switch level {
+	case "DEBUG", "INFO":
+		tflog.Debug(ctx, message, fields)
+	case "TRACE":
+		tflog.Trace(ctx, message, fields)
+	case "WARN":
+		tflog.Warn(ctx, message, fields)
+	case "ERROR":
+		tflog.Error(ctx, message, fields)
+	default:
+		self.Debug("Unknown log level %s, defaulting to TRACE", level)
+		tflog.Trace(ctx, message, fields)
+	}
+
+	if self.apiCallsLog != nil {
+		if err := self.apiCallsLog.Write(call); err != nil {
+			self.Error("Unable to write API call to log file, got error: %s", err)
+		}
+	}
+}
+
+func (self *AriaClient) Error(message string, args ...any) {
 	tflog.Error(self.Context, fmt.Sprintf(message, args...))
 }
 
-func (self AriaClient) Warn(message string, args ...any) {
+func (self *AriaClient) Warn(message string, args ...any) {
 	tflog.Warn(self.Context, fmt.Sprintf(message, args...))
 }
 
-func (self AriaClient) Debug(message string, args ...any) {
+func (self *AriaClient) Debug(message string, args ...any) {
 	tflog.Debug(self.Context, fmt.Sprintf(message, args...))
 }
 
-func (self AriaClient) Info(message string, args ...any) {
+func (self *AriaClient) Info(message string, args ...any) {
 	tflog.Debug(self.Context, fmt.Sprintf(message, args...))
 }
 
-func (self AriaClient) Trace(message string, args ...any) {
+func (self *AriaClient) Trace(message string, args ...any) {
 	tflog.Trace(self.Context, fmt.Sprintf(message, args...))
 }
 
-func (self AriaClient) Log(level string, message string, args ...any) {
+func (self *AriaClient) Log(level string, message string, args ...any) {
 	// Sorted by occurrences to optimize branching a little bit
 	if level == "DEBUG" {
 		self.Debug(message, args...)
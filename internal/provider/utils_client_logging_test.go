@@ -0,0 +1,104 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestAriaClientAPICallsLogFile(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "api-calls.jsonl")
+	requestIds := []string{}
+	client := NewTestClient(t, AriaClient{
+		APICallsLogFile: logFile,
+		MaxRetries:      1,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		requestIds = append(requestIds, r.Header.Get(REQUEST_ID_HEADER))
+		w.Header().Set("Content-Type", "application/json")
+		if len(requestIds) == 1 {
+			w.WriteHeader(503)
+		}
+		fmt.Fprint(w, `{"token":"secret"}`)
+	})
+
+	response, err := client.R(t.Context(), "iaas/api/projects").Get("iaas/api/projects")
+	CheckEqual(t, client.HandleAPIResponse(response, err, []int{200}), nil)
+
+	// Request ID is kept across retries
+	CheckEqual(t, len(requestIds), 2)
+	CheckEqual(t, requestIds[0], requestIds[1])
+
+	content, err := os.ReadFile(logFile)
+	CheckEqual(t, err, nil)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	CheckEqual(t, len(lines), 1)
+
+	var call APICallLog
+	CheckEqual(t, json.Unmarshal([]byte(lines[0]), &call), nil)
+	CheckEqual(t, call.RequestId, requestIds[0])
+	CheckEqual(t, call.Method, "GET")
+	CheckEqual(t, call.Path, "/iaas/api/projects")
+	CheckEqual(t, call.Attempt, 2)
+	CheckEqual(t, call.StatusCode, 200)
+	CheckEqual(t, call.Expected, "200")
+	CheckEqual(t, call.ResponseBody, "{\n\t\"token\": \"<redacted>\"\n}")
+	CheckEqual(t, strings.Contains(call.RequestHeaders, "Authorization: <redacted>"), true)
+}
+
+func TestAriaClientAPICallsLogFileUnwritable(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "missing", "api-calls.jsonl")
+	client := AriaClient{
+		Host:               "https://aria.example.com",
+		AccessToken:        "some-access-token",
+		APICallsLogFile:    logFile,
+		Context:            t.Context(),
+		OKAPICallsLogLevel: "TRACE",
+		KOAPICallsLogLevel: "TRACE",
+	}
+	CheckDiagnostics(t, client.Init(), "", "Unable to open API calls log file "+logFile)
+}
+
+func TestAriaClientAPICallsLogRedactedError(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "api-calls.jsonl")
+	client := NewTestClient(t, AriaClient{
+		APICallsLogFile:   logFile,
+		Headers:           map[string]string{"X-Team-Key": "header-secret"},
+		MaxLoggedBodySize: 60,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(400)
+		fmt.Fprintf(w, `{"message":"Invalid","password":"body-secret","trace":"%s"}`,
+			strings.Repeat("x", 100))
+	})
+
+	output := strings.Builder{}
+	ctx := tflogtest.RootLogger(t.Context(), &output)
+	response, err := client.R(ctx, "iaas/api/projects").Get("iaas/api/projects")
+	err = client.HandleAPIResponse(response, err, []int{200})
+
+	// Error (reported to the user) is redacted and truncated
+	CheckEqual(t, strings.Contains(err.Error(), `"password":"<redacted>"`), true)
+	CheckEqual(t, strings.Contains(err.Error(), "bytes truncated"), true)
+
+	content, readErr := os.ReadFile(logFile)
+	CheckEqual(t, readErr, nil)
+	for name, text := range map[string]string{
+		"error": err.Error(), "log line": output.String(), "log file": string(content),
+	} {
+		for _, secret := range []string{"body-secret", "header-secret"} {
+			if strings.Contains(text, secret) {
+				t.Errorf("Secret %s found in %s: %s", secret, name, text)
+			}
+		}
+	}
+	CheckEqual(t, strings.Contains(output.String(), "X-Team-Key"), true)
+}
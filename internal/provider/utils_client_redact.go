@@ -0,0 +1,228 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+const REDACTED = "<redacted>"
+
+// API fields always redacted from logs, wherever they are (credentials and tokens).
+var SENSITIVE_API_FIELDS = []string{
+	"access_token",
+	"client_secret",
+	"id_token",
+	"password",
+	"refresh_token",
+	"token",
+}
+
+// Headers always redacted from logs.
+var SENSITIVE_API_HEADERS = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"Set-Cookie",
+	"X-Api-Key",
+}
+
+// Resources declaring the API paths exchanging their sensitive (top level) attributes, those are
+// redacted from the bodies of any API path otherwise.
+type ResourceWithSensitivePaths interface {
+	SensitivePaths() []string
+}
+
+// Fields redacted from the API calls logs. Names are normalized (see NormalizeFieldName).
+type SensitiveFields struct {
+	// Redacted wherever they are.
+	Fields map[string]bool
+
+	// Redacted at the root of the body (top level attributes of a resource), including inside
+	// the content of a list, of the API paths starting with given prefixes ("" for any path).
+	RootFields map[string][]string
+
+	// Redacted inside an object with given key (nested attributes), key is "parent.field".
+	NestedFields map[string]bool
+}
+
+// Return sensitive fields including SENSITIVE_API_FIELDS and the sensitive attributes of the
+// resources' schemas.
+func GetSensitiveFields(ctx context.Context, resources []func() resource.Resource) SensitiveFields {
+	fields := SensitiveFields{
+		Fields:       map[string]bool{},
+		RootFields:   map[string][]string{},
+		NestedFields: map[string]bool{},
+	}
+	for _, name := range SENSITIVE_API_FIELDS {
+		fields.Fields[NormalizeFieldName(name)] = true
+	}
+	for _, newResource := range resources {
+		instance := newResource()
+		paths := []string{""}
+		if withPaths, ok := instance.(ResourceWithSensitivePaths); ok {
+			paths = withPaths.SensitivePaths()
+		}
+		response := resource.SchemaResponse{}
+		instance.Schema(ctx, resource.SchemaRequest{}, &response)
+		fields.AddAttributes(response.Schema.Attributes, "", paths)
+	}
+	return fields
+}
+
+// Add the sensitive attributes (recursively), parent is the name of the nested attribute.
+// Top level attributes are only redacted from the bodies of given API paths (prefixes).
+func (self *SensitiveFields) AddAttributes(
+	attributes map[string]schema.Attribute,
+	parent string,
+	paths []string,
+) {
+	for name, attribute := range attributes {
+		name = NormalizeFieldName(name)
+		if attribute.IsSensitive() {
+			if len(parent) == 0 {
+				self.RootFields[name] = append(self.RootFields[name], paths...)
+			} else {
+				self.NestedFields[parent+"."+name] = true
+			}
+			continue
+		}
+		switch nested := attribute.(type) {
+		case schema.SingleNestedAttribute:
+			self.AddAttributes(nested.Attributes, name, paths)
+		case schema.ListNestedAttribute:
+			self.AddAttributes(nested.NestedObject.Attributes, name, paths)
+		case schema.MapNestedAttribute:
+			self.AddAttributes(nested.NestedObject.Attributes, name, paths)
+		case schema.SetNestedAttribute:
+			self.AddAttributes(nested.NestedObject.Attributes, name, paths)
+		}
+	}
+}
+
+// Return true if the field (inside an object with key parent) of a body of the API path (or URL)
+// is sensitive.
+func (self *SensitiveFields) IsSensitive(name string, parent string, path string, root bool) bool {
+	name = NormalizeFieldName(name)
+	return self.Fields[name] ||
+		(root && slices.ContainsFunc(self.RootFields[name], func(prefix string) bool {
+			return strings.HasPrefix(NormalizeAPIPath(path), prefix)
+		})) ||
+		(len(parent) > 0 && self.NestedFields[NormalizeFieldName(parent)+"."+name])
+}
+
+// Return true if the variable (e.g. test_vsphere_password) holds the value of a sensitive field,
+// its name is ending with the name of a field redacted wherever it is or at the root of a body.
+func (self *SensitiveFields) IsSensitiveVariable(name string) bool {
+	name = NormalizeFieldName(name)
+	for field := range self.Fields {
+		if strings.HasSuffix(name, field) {
+			return true
+		}
+	}
+	for field := range self.RootFields {
+		if strings.HasSuffix(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// Return a copy of the JSON data (body of the API path) with sensitive fields redacted.
+func (self *SensitiveFields) Redact(path string, data any) any {
+	return self.redact(data, "", path, true)
+}
+
+func (self *SensitiveFields) redact(data any, parent string, path string, root bool) any {
+	switch value := data.(type) {
+	case map[string]any:
+		redacted := make(map[string]any, len(value))
+		for key, item := range value {
+			if item != nil && self.IsSensitive(key, parent, path, root) {
+				redacted[key] = REDACTED
+			} else {
+				// Items of a list are considered root level (e.g. list of sensitive constants)
+				redacted[key] = self.redact(item, key, path, root && key == "content")
+			}
+		}
+		return redacted
+	case []any:
+		redacted := make([]any, len(value))
+		for index, item := range value {
+			redacted[index] = self.redact(item, parent, path, root)
+		}
+		return redacted
+	}
+	return data
+}
+
+// Return the body (of the API path) as indented JSON with sensitive fields redacted, or as is if
+// not JSON.
+func (self *SensitiveFields) RedactBody(path string, body []byte) []byte {
+	var data any
+	if json.Unmarshal(body, &data) != nil {
+		return body
+	}
+	redacted := bytes.Buffer{}
+	encoder := json.NewEncoder(&redacted)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "\t")
+	if encoder.Encode(self.Redact(path, data)) != nil {
+		return body
+	}
+	return bytes.TrimSuffix(redacted.Bytes(), []byte("\n"))
+}
+
+// Return the API path (e.g. iaas/api/projects) of the URL (or path).
+func NormalizeAPIPath(path string) string {
+	if location, err := url.Parse(path); err == nil {
+		path = location.Path
+	}
+	return strings.TrimPrefix(path, "/")
+}
+
+// Return the headers as text with sensitive headers (and the additional ones) redacted.
+func RedactHeaders(headers http.Header, sensitive ...string) string {
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	lines := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value := strings.Join(headers.Values(key), ", ")
+		isSensitive := func(header string) bool { return strings.EqualFold(header, key) }
+		if slices.ContainsFunc(SENSITIVE_API_HEADERS, isSensitive) ||
+			slices.ContainsFunc(sensitive, isSensitive) {
+			value = REDACTED
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", key, value))
+	}
+	return strings.Join(lines, "; ")
+}
+
+// Return the body truncated to given size (in bytes), unlimited if zero.
+func TruncateBody(body []byte, maxSize int) []byte {
+	if maxSize <= 0 || len(body) <= maxSize {
+		return body
+	}
+	return fmt.Appendf(body[:maxSize:maxSize], "... (%d bytes truncated)", len(body)-maxSize)
+}
+
+// Return the name without case and separators (e.g. systemCredentials, system_credentials and
+// system-credentials are the same).
+func NormalizeFieldName(name string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+}
@@ -0,0 +1,110 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestSensitiveFieldsRedactBody(t *testing.T) {
+	fields := GetSensitiveFields(t.Context(), []func() resource.Resource{
+		NewABXSensitiveConstantResource,
+		NewOrchestratorConfigurationResource,
+		NewOrchestratorEnvironmentRepositoryResource,
+	})
+
+	cases := []struct {
+		name     string
+		path     string
+		body     string
+		expected string
+	}{
+		{
+			"Login",
+			"csp/gateway/am/api/login",
+			`{"refreshToken":"secret"}`,
+			`{"refreshToken":"<redacted>"}`,
+		},
+		{
+			"Sensitive constant",
+			"abx/api/resources/action-secrets",
+			`{"name":"key","value":"secret","encrypted":true}`,
+			`{"encrypted":true,"name":"key","value":"<redacted>"}`,
+		},
+		{
+			"Sensitive constants list",
+			"/abx/api/resources/action-secrets?page=0",
+			`{"content":[{"name":"key","value":"secret"}]}`,
+			`{"content":[{"name":"key","value":"<redacted>"}]}`,
+		},
+		{
+			"Environment repository",
+			"vco/api/environments/repositories",
+			`{"name":"pypi","systemUser":"user","systemCredentials":"secret"}`,
+			`{"name":"pypi","systemCredentials":"<redacted>","systemUser":"user"}`,
+		},
+		{
+			"Configuration secure string",
+			"vco/api/configurations",
+			`{"attributes":[{"name":"a","value":{"secure-string":{"value":"secret"}}},` +
+				`{"name":"b","value":{"string":{"value":"visible"}}}]}`,
+			`{"attributes":[{"name":"a","value":{"secure-string":{"value":"<redacted>"}}},` +
+				`{"name":"b","value":{"string":{"value":"visible"}}}]}`,
+		},
+		{
+			"Value of another API",
+			"https://aria.example.com/properties/api/property-groups/some-id",
+			`{"name":"key","value":"visible"}`,
+			`{"name":"key","value":"visible"}`,
+		},
+		{
+			"Not JSON",
+			"iaas/api/projects",
+			`some text`,
+			`some text`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := fields.RedactBody(tc.path, []byte(tc.body))
+			compacted := bytes.Buffer{}
+			if json.Compact(&compacted, result) == nil {
+				result = compacted.Bytes()
+			}
+			CheckEqual(t, string(result), tc.expected)
+		})
+	}
+}
+
+func TestSensitiveFieldsIsSensitiveVariable(t *testing.T) {
+	fields := GetSensitiveFields(t.Context(), []func() resource.Resource{
+		NewABXSensitiveConstantResource,
+	})
+	CheckEqual(t, fields.IsSensitiveVariable("test_vsphere_password"), true)
+	CheckEqual(t, fields.IsSensitiveVariable("test_refresh_token"), true)
+	CheckEqual(t, fields.IsSensitiveVariable("test_constant_value"), true)
+	CheckEqual(t, fields.IsSensitiveVariable("test_secret_id"), false)
+	CheckEqual(t, fields.IsSensitiveVariable("test_vsphere_username"), false)
+}
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer secret")
+	headers.Set("Content-Type", "application/json")
+	headers.Set("X-Team-Key", "secret")
+	CheckEqual(
+		t, RedactHeaders(headers, "x-team-key"),
+		"Authorization: <redacted>; Content-Type: application/json; X-Team-Key: <redacted>")
+}
+
+func TestTruncateBody(t *testing.T) {
+	CheckEqual(t, string(TruncateBody([]byte("0123456789"), 0)), "0123456789")
+	CheckEqual(t, string(TruncateBody([]byte("0123456789"), 10)), "0123456789")
+	CheckEqual(t, string(TruncateBody([]byte("0123456789"), 4)), "0123... (6 bytes truncated)")
+}
@@ -0,0 +1,126 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const DEFAULT_MAX_RETRIES = 3
+const DEFAULT_MIN_BACKOFF = 1 * time.Second
+const DEFAULT_MAX_BACKOFF = 30 * time.Second
+
+// API status codes worth a retry (throttled or temporarily unavailable).
+var TRANSIENT_STATUS_CODES = []int{429, 502, 503, 504}
+
+// API status codes worth a retry whatever the method, the request was not processed by the API.
+// The others (e.g. gateway errors) are only retried for idempotent methods.
+var NOT_PROCESSED_STATUS_CODES = []int{429, 503}
+
+// HTTP methods that can be safely retried (the request may have been processed by the API).
+var IDEMPOTENT_METHODS = []string{
+	http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete,
+}
+
+type unauthorizedRetryKey struct{}
+
+// Setup retry policy of the resty client.
+// Transient errors are retried up to MaxRetries times and unauthorized errors once.
+func (self *AriaClient) SetupRetryPolicy(client *resty.Client) {
+	client.SetRetryCount(max(self.MaxRetries, 1))
+	client.SetRetryWaitTime(self.MinBackoff)
+	client.SetRetryMaxWaitTime(self.MaxBackoff)
+	client.SetRetryAfter(GetRetryAfter)
+	client.AddRetryCondition(self.ShouldRetryUnauthorized)
+	client.AddRetryCondition(self.ShouldRetryTransientError)
+}
+
+// Retry requests that failed with a transient error (throttled, gateway error, connection reset).
+// Gateway errors and connection resets are only retried for idempotent methods (not POST, PATCH).
+// Registered as a retry condition of the resty client.
+func (self *AriaClient) ShouldRetryTransientError(response *resty.Response, err error) bool {
+	if response == nil || response.Request.Attempt > self.MaxRetries {
+		return false
+	}
+
+	idempotent := slices.Contains(IDEMPOTENT_METHODS, response.Request.Method)
+	var reason string
+	if err != nil {
+		if !IsConnectionReset(err) || !idempotent {
+			return false
+		}
+		reason = err.Error()
+	} else {
+		statusCode := response.StatusCode()
+		if !slices.Contains(TRANSIENT_STATUS_CODES, statusCode) ||
+			!idempotent && !slices.Contains(NOT_PROCESSED_STATUS_CODES, statusCode) {
+			return false
+		}
+		reason = response.Status()
+	}
+
+	self.Debug(
+		"API call to %s failed with a transient error (%s), retry %d of %d",
+		response.Request.URL, reason, response.Request.Attempt, self.MaxRetries)
+	return true
+}
+
+// Return true if the request has not been already retried because unauthorized.
+// Mark the request as retried (unauthorized) at the same time.
+func MarkUnauthorizedRetry(request *resty.Request) bool {
+	ctx := request.Context()
+	if ctx.Value(unauthorizedRetryKey{}) != nil {
+		return false
+	}
+	request.SetContext(context.WithValue(ctx, unauthorizedRetryKey{}, true))
+	return true
+}
+
+// Return true if the error is due to the connection being reset or closed by the server.
+func IsConnectionReset(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// Return the duration to wait before retrying, as requested by the API (Retry-After header).
+// Zero means using the default backoff algorithm.
+func GetRetryAfter(client *resty.Client, response *resty.Response) (time.Duration, error) {
+	value := response.Header().Get("Retry-After")
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// Return the duration to wait before given retry attempt (starting at 0).
+// Capped exponential backoff with jitter (same algorithm as the one used for API calls).
+func (self *AriaClient) GetBackoff(attempt int) time.Duration {
+	backoff := math.Min(float64(self.MaxBackoff), float64(self.MinBackoff)*math.Exp2(float64(attempt)))
+	half := max(int64(backoff/2), 1)
+	return max(time.Duration(half+rand.Int64N(half)), self.MinBackoff)
+}
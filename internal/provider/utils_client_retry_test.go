@@ -0,0 +1,113 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Return an Aria client talking to a fake API failing with given status codes before succeeding.
+func NewTestRetryClient(t *testing.T, maxRetries int, statusCodes ...int) (*AriaClient, *atomic.Int32) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(calls.Add(1))
+		w.Header().Set("Content-Type", "application/json")
+		if call <= len(statusCodes) {
+			w.WriteHeader(statusCodes[call-1])
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+	t.Cleanup(server.Close)
+
+	client := AriaClient{
+		Host:               server.URL,
+		AccessToken:        "some-access-token",
+		MaxRetries:         maxRetries,
+		MinBackoff:         time.Millisecond,
+		MaxBackoff:         10 * time.Millisecond,
+		Context:            t.Context(),
+		OKAPICallsLogLevel: "TRACE",
+		KOAPICallsLogLevel: "TRACE",
+	}
+	CheckDiagnostics(t, client.Init(), "", "")
+	return &client, &calls
+}
+
+func TestAriaClientRetryTransientErrors(t *testing.T) {
+	client, calls := NewTestRetryClient(t, 3, 503, 429, 502)
+	response, err := client.R(t.Context(), "iaas/api/projects").Get("iaas/api/projects")
+	CheckEqual(t, client.HandleAPIResponse(response, err, []int{200}), nil)
+	CheckEqual(t, calls.Load(), int32(4))
+}
+
+func TestAriaClientRetryTransientErrorsExhausted(t *testing.T) {
+	client, calls := NewTestRetryClient(t, 1, 504, 504, 504)
+	response, _ := client.R(t.Context(), "iaas/api/projects").Get("iaas/api/projects")
+	CheckEqual(t, response.StatusCode(), 504)
+	CheckEqual(t, calls.Load(), int32(2))
+}
+
+func TestAriaClientRetryNonIdempotent(t *testing.T) {
+	// Throttled requests were not processed, they are retried
+	client, calls := NewTestRetryClient(t, 3, 429, 503)
+	response, err := client.R(t.Context(), "iaas/api/projects").Post("iaas/api/projects")
+	CheckEqual(t, client.HandleAPIResponse(response, err, []int{200}), nil)
+	CheckEqual(t, calls.Load(), int32(3))
+
+	// Gateway errors may have been processed, they are not retried
+	for _, statusCode := range []int{502, 504} {
+		client, calls = NewTestRetryClient(t, 3, statusCode)
+		response, _ = client.R(t.Context(), "iaas/api/projects").Patch("iaas/api/projects")
+		CheckEqual(t, response.StatusCode(), statusCode)
+		CheckEqual(t, calls.Load(), int32(1))
+	}
+}
+
+func TestAriaClientNoRetryOnClientErrors(t *testing.T) {
+	client, calls := NewTestRetryClient(t, 3, 400)
+	response, _ := client.R(t.Context(), "iaas/api/projects").Get("iaas/api/projects")
+	CheckEqual(t, response.StatusCode(), 400)
+	CheckEqual(t, calls.Load(), int32(1))
+}
+
+func TestGetRetryAfter(t *testing.T) {
+	cases := []struct {
+		name     string
+		header   string
+		expected time.Duration
+	}{
+		{"Missing", "", 0},
+		{"Seconds", "7", 7 * time.Second},
+		{"Date in the past", "Wed, 21 Oct 2015 07:28:00 GMT", 0},
+		{"Garbage", "soon", 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			response := &resty.Response{RawResponse: &http.Response{Header: http.Header{}}}
+			if len(tc.header) > 0 {
+				response.RawResponse.Header.Set("Retry-After", tc.header)
+			}
+			result, err := GetRetryAfter(nil, response)
+			CheckEqual(t, err, nil)
+			CheckEqual(t, result, tc.expected)
+		})
+	}
+}
+
+func TestAriaClientGetBackoff(t *testing.T) {
+	client := AriaClient{MinBackoff: time.Second, MaxBackoff: 10 * time.Second}
+	for attempt := range 10 {
+		backoff := client.GetBackoff(attempt)
+		if backoff < client.MinBackoff || backoff > client.MaxBackoff {
+			t.Errorf("Backoff %s of attempt %d is out of bounds", backoff, attempt)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+)
+
+// Return the TLS configuration for the API calls (trusted CAs, client certificate, ...).
+func (self *AriaClient) GetTLSConfig() (*tls.Config, error) {
+	config := &tls.Config{InsecureSkipVerify: self.Insecure}
+
+	// Trust the given CA certificates in addition to the ones of the system
+	if len(self.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(self.CACertPEM)) {
+			return nil, errors.New("CA certificate does not contain any valid PEM certificate")
+		}
+		config.RootCAs = pool
+	}
+
+	// Authenticate with a client certificate (mutual TLS)
+	if len(self.ClientCertPEM) > 0 || len(self.ClientKeyPEM) > 0 {
+		if len(self.ClientCertPEM) == 0 || len(self.ClientKeyPEM) == 0 {
+			return nil, errors.New("both client certificate and key are required for mutual TLS")
+		}
+		certificate, err := tls.X509KeyPair([]byte(self.ClientCertPEM), []byte(self.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, nil
+}
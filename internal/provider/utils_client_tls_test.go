@@ -0,0 +1,44 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAriaClientCACert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	}))
+	t.Cleanup(server.Close)
+
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	client := AriaClient{
+		Host:               server.URL,
+		AccessToken:        "some-access-token",
+		CACertPEM:          string(caCert),
+		Context:            t.Context(),
+		OKAPICallsLogLevel: "TRACE",
+		KOAPICallsLogLevel: "TRACE",
+	}
+	CheckDiagnostics(t, client.Init(), "", "")
+
+	response, err := client.R(t.Context(), "iaas/api/projects").Get("iaas/api/projects")
+	CheckEqual(t, client.HandleAPIResponse(response, err, []int{200}), nil)
+}
+
+func TestAriaClientGetTLSConfigErrors(t *testing.T) {
+	client := AriaClient{CACertPEM: "not a certificate"}
+	_, err := client.GetTLSConfig()
+	CheckEqual(t, err.Error(), "CA certificate does not contain any valid PEM certificate")
+
+	client = AriaClient{ClientCertPEM: "-----BEGIN CERTIFICATE-----"}
+	_, err = client.GetTLSConfig()
+	CheckEqual(t, err.Error(), "both client certificate and key are required for mutual TLS")
+}
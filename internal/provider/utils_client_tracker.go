@@ -0,0 +1,80 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Status of the asynchronous requests of the IaaS API.
+const REQUEST_TRACKER_IN_PROGRESS = "INPROGRESS"
+const REQUEST_TRACKER_FINISHED = "FINISHED"
+const REQUEST_TRACKER_FAILED = "FAILED"
+
+// RequestTrackerAPIModel describes the tracker of an asynchronous request (e.g. creating a cloud
+// account) as returned by the IaaS API.
+type RequestTrackerAPIModel struct {
+	Id        string   `json:"id"`
+	Name      string   `json:"name"`
+	Progress  int      `json:"progress"`
+	Status    string   `json:"status"`
+	Message   string   `json:"message"`
+	Resources []string `json:"resources"`
+}
+
+func (self RequestTrackerAPIModel) ReadPath() string {
+	return "iaas/api/request-tracker/" + self.Id
+}
+
+// Return the identifier of the resource (the first one) created or updated by the request.
+func (self RequestTrackerAPIModel) ResourceId() string {
+	if len(self.Resources) == 0 {
+		return ""
+	}
+	return path.Base(self.Resources[0])
+}
+
+// Poll the request until it is finished (or failed) or the operation times out (see timeouts).
+// Name describes the request (e.g. "creating Cloud Account X") for reporting errors.
+func (self *AriaClient) WaitRequestTracker(
+	ctx context.Context,
+	tracker RequestTrackerAPIModel,
+	name string,
+) (RequestTrackerAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	interval := self.GetPollInterval(ctx, time.Duration(10)*time.Second)
+	for attempt := 0; ; attempt++ {
+		switch tracker.Status {
+		case REQUEST_TRACKER_FINISHED:
+			return tracker, diags
+		case REQUEST_TRACKER_FAILED:
+			diags.AddError(
+				"Client error",
+				fmt.Sprintf("Failure while %s, got error: %s", name, tracker.Message))
+			return tracker, diags
+		}
+
+		if err := Sleep(ctx, interval); err != nil {
+			diags.Append(InterruptedDiagnostics(err, name)...)
+			return tracker, diags
+		}
+		self.Debug("Poll %d - Check request %s is finished (%s)...", attempt+1, tracker.Id, name)
+
+		readPath := tracker.ReadPath()
+		response, err := self.R(ctx, readPath).SetResult(&tracker).Get(readPath)
+		err = self.HandleAPIResponse(response, err, []int{200})
+		if err != nil {
+			diags.AddError(
+				"Client error",
+				fmt.Sprintf("Unable to poll request %s while %s, got error: %s",
+					tracker.Id, name, err))
+			return tracker, diags
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Return an Aria client talking to a fake API finishing the request after given number of polls.
+func NewTestTrackerClient(t *testing.T, polls int32, status string) (*AriaClient, *atomic.Int32) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/iaas/api/request-tracker/tracker-1" {
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"message":"Not Found"}`)
+			return
+		}
+		trackerStatus := REQUEST_TRACKER_IN_PROGRESS
+		if calls.Add(1) >= polls {
+			trackerStatus = status
+		}
+		fmt.Fprintf(w,
+			`{"id":"tracker-1","status":"%s","message":"Some message","resources":[%s]}`,
+			trackerStatus, `"/iaas/api/cloud-accounts/account-1"`)
+	}))
+	t.Cleanup(server.Close)
+
+	client := AriaClient{
+		Host:               server.URL,
+		AccessToken:        "some-access-token",
+		Context:            t.Context(),
+		OKAPICallsLogLevel: "TRACE",
+		KOAPICallsLogLevel: "TRACE",
+	}
+	CheckDiagnostics(t, client.Init(), "", "")
+	return &client, &calls
+}
+
+func TestRequestTrackerResourceId(t *testing.T) {
+	CheckEqual(t, RequestTrackerAPIModel{}.ResourceId(), "")
+	tracker := RequestTrackerAPIModel{
+		Resources: []string{"/iaas/api/cloud-accounts/account-1", "/iaas/api/zones/zone-1"},
+	}
+	CheckEqual(t, tracker.ResourceId(), "account-1")
+}
+
+func TestWaitRequestTrackerFinished(t *testing.T) {
+	client, calls := NewTestTrackerClient(t, 1, REQUEST_TRACKER_FINISHED)
+
+	// Already finished, the API is not called
+	tracker := RequestTrackerAPIModel{Id: "tracker-1", Status: REQUEST_TRACKER_FINISHED}
+	_, diags := client.WaitRequestTracker(t.Context(), tracker, "creating X")
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, calls.Load(), int32(0))
+
+	// In progress, polled until finished
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	tracker.Status = REQUEST_TRACKER_IN_PROGRESS
+	tracker, diags = client.WaitRequestTracker(ctx, tracker, "creating X")
+	CheckDiagnostics(t, diags, "", "")
+	CheckEqual(t, calls.Load(), int32(1))
+	CheckEqual(t, tracker.Status, REQUEST_TRACKER_FINISHED)
+	CheckEqual(t, tracker.ResourceId(), "account-1")
+}
+
+func TestWaitRequestTrackerFailed(t *testing.T) {
+	client, _ := NewTestTrackerClient(t, 1, REQUEST_TRACKER_FAILED)
+	ctx, cancel := context.WithTimeout(t.Context(), 5*time.Second)
+	defer cancel()
+	tracker := RequestTrackerAPIModel{Id: "tracker-1", Status: REQUEST_TRACKER_IN_PROGRESS}
+	_, diags := client.WaitRequestTracker(ctx, tracker, "creating X")
+	CheckDiagnostics(t, diags, "", "Failure while creating X, got error: Some message")
+}
+
+func TestWaitRequestTrackerTimeout(t *testing.T) {
+	client, _ := NewTestTrackerClient(t, 1000, REQUEST_TRACKER_FINISHED)
+	ctx, cancel := context.WithTimeout(t.Context(), 1500*time.Millisecond)
+	defer cancel()
+	tracker := RequestTrackerAPIModel{Id: "tracker-1", Status: REQUEST_TRACKER_IN_PROGRESS}
+	_, diags := client.WaitRequestTracker(ctx, tracker, "creating X")
+	CheckDiagnostics(t, diags, "", "Timeout while creating X")
+}
@@ -3,9 +3,13 @@
 
 package provider
 
+import "time"
+
 const ABX_API_VERSION = "2019-09-12"
 const BLUEPRINT_API_VERSION = "2019-09-12"
 const CATALOG_API_VERSION = "2020-08-25"
+const CSP_API_VERSION = ""
+const DEPLOYMENT_API_VERSION = "2020-08-25"
 const EVENT_BROKER_API_VERSION = "" // 7.6 ?? https://developer.vmware.com/apis/576/#api
 const FORM_API_VERSION = "1.0"
 const IAAS_API_VERSION = "2021-07-15"
@@ -16,6 +20,27 @@ const POLICY_API_VERSION = "2020-08-25"
 const PROJECT_API_VERSION = "2019-01-15"
 const PLATFORM_API_VERSION = ""
 
+// Default timeouts and polling -------------------------------------------------------------------
+
+const DEFAULT_CREATE_TIMEOUT = 5 * time.Minute
+const DEFAULT_UPDATE_TIMEOUT = 5 * time.Minute
+const DEFAULT_DELETE_TIMEOUT = 5 * time.Minute
+
+// Default timeout for operations waiting for an asynchronous import (e.g. workflow, catalog source).
+const DEFAULT_IMPORT_TIMEOUT = 20 * time.Minute
+
+// Default timeout for operations waiting for a deployment to be provisioned, updated or destroyed.
+const DEFAULT_DEPLOYMENT_TIMEOUT = 60 * time.Minute
+
+// Default timeout of a single API call.
+const DEFAULT_REQUEST_TIMEOUT = 2 * time.Minute
+
+// Number of polls fitting into the timeout (if possible, see GetPollInterval).
+const POLL_COUNT = 30
+
+// Number of polls when waiting for an instance to be deleted without deadline.
+const DELETE_POLL_COUNT = 5
+
 // Helpers for documenting attributes in schema ----------------------------------------------------
 
 const IMMUTABLE = " (force recreation on change)"
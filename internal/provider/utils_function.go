@@ -0,0 +1,294 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Convert a value passed to a function (e.g. a map of objects declared in HCL) to a map of
+// objects following the schema of a resource's nested attribute, as the resource would receive it
+// (missing attributes are set to their default, if any). Name is used for reporting errors.
+//
+// The attribute keyAttribute of the objects is set to their key if missing (e.g. name).
+func MapFromDynamic(
+	ctx context.Context,
+	name string,
+	value attr.Value,
+	nested schema.NestedAttributeObject,
+	keyAttribute string,
+) (map[string]types.Object, error) {
+	elements, err := ElementsFromDynamic(name, value)
+	if err != nil {
+		return nil, err
+	}
+	objects := map[string]types.Object{}
+	for key, element := range elements {
+		elementName := fmt.Sprintf("%s[%q]", name, key)
+		attributes, err := ElementsFromDynamic(elementName, element)
+		if err != nil {
+			return nil, err
+		}
+		if _, found := attributes[keyAttribute]; !found && len(keyAttribute) > 0 {
+			attributes[keyAttribute] = types.StringValue(key)
+		}
+		objects[key], err = ObjectFromDynamic(ctx, elementName, attributes, nested)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return objects, nil
+}
+
+// Convert attributes to an object following the nested attribute schema.
+func ObjectFromDynamic(
+	ctx context.Context,
+	name string,
+	attributes map[string]attr.Value,
+	nested schema.NestedAttributeObject,
+) (types.Object, error) {
+	attrTypes := map[string]attr.Type{}
+	values := map[string]attr.Value{}
+	for _, key := range slices.Sorted(maps.Keys(nested.Attributes)) {
+		attribute := nested.Attributes[key]
+		value, err := ValueFromDynamic(ctx, name+"."+key, attributes[key], attribute)
+		if err != nil {
+			return types.ObjectNull(attrTypes), err
+		}
+		attrTypes[key] = attribute.GetType()
+		values[key] = value
+	}
+	for key := range attributes {
+		if _, found := nested.Attributes[key]; !found {
+			return types.ObjectNull(attrTypes), fmt.Errorf(
+				"%s.%s is not supported, must be one of %s",
+				name, key, strings.Join(slices.Sorted(maps.Keys(nested.Attributes)), ", "))
+		}
+	}
+	object, diags := types.ObjectValue(attrTypes, values)
+	if diags.HasError() {
+		return object, fmt.Errorf("unable to convert %s: %s", name, diags.Errors()[0].Detail())
+	}
+	return object, nil
+}
+
+// Convert a value (nil if missing) to the type of the attribute.
+func ValueFromDynamic(
+	ctx context.Context,
+	name string,
+	value attr.Value,
+	attribute schema.Attribute,
+) (attr.Value, error) {
+	if dynamic, ok := value.(basetypes.DynamicValue); ok {
+		value = dynamic.UnderlyingValue()
+	}
+
+	if value == nil || value.IsNull() {
+		if attribute.IsRequired() {
+			return nil, fmt.Errorf("%s is required", name)
+		}
+		return DefaultFromSchema(ctx, attribute)
+	}
+
+	switch attribute := attribute.(type) {
+	case schema.StringAttribute:
+		stringValue, ok := value.(basetypes.StringValue)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a string", name)
+		}
+		if attribute.CustomType != nil {
+			customValue, diags := attribute.CustomType.ValueFromString(ctx, stringValue)
+			if diags.HasError() {
+				return nil, fmt.Errorf("%s is invalid: %s", name, diags.Errors()[0].Detail())
+			}
+			return customValue, nil
+		}
+		return stringValue, nil
+	case schema.BoolAttribute:
+		if _, ok := value.(basetypes.BoolValue); !ok {
+			return nil, fmt.Errorf("%s must be a boolean", name)
+		}
+		return value, nil
+	case schema.Int64Attribute, schema.Int32Attribute:
+		return IntegerFromDynamic(name, value, attribute.GetType())
+	case schema.ListAttribute:
+		elements, err := ListElementsFromDynamic(name, value)
+		if err != nil {
+			return nil, err
+		}
+		values := []attr.Value{}
+		for index, element := range elements {
+			elementName := fmt.Sprintf("%s[%d]", name, index)
+			var elementValue attr.Value
+			switch attribute.ElementType {
+			case types.StringType:
+				if _, ok := element.(basetypes.StringValue); !ok {
+					return nil, fmt.Errorf("%s must be a string", elementName)
+				}
+				elementValue = element
+			case types.Int64Type, types.Int32Type:
+				elementValue, err = IntegerFromDynamic(elementName, element, attribute.ElementType)
+				if err != nil {
+					return nil, err
+				}
+			default:
+				return nil, fmt.Errorf(
+					"%s has an unsupported type %s", elementName, attribute.ElementType)
+			}
+			values = append(values, elementValue)
+		}
+		list, diags := types.ListValue(attribute.ElementType, values)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to convert %s: %s", name, diags.Errors()[0].Detail())
+		}
+		return list, nil
+	case schema.SingleNestedAttribute:
+		attributes, err := ElementsFromDynamic(name, value)
+		if err != nil {
+			return nil, err
+		}
+		return ObjectFromDynamic(
+			ctx, name, attributes, schema.NestedAttributeObject{Attributes: attribute.Attributes})
+	case schema.ListNestedAttribute:
+		elements, err := ListElementsFromDynamic(name, value)
+		if err != nil {
+			return nil, err
+		}
+		objects := []attr.Value{}
+		for index, element := range elements {
+			elementName := fmt.Sprintf("%s[%d]", name, index)
+			attributes, err := ElementsFromDynamic(elementName, element)
+			if err != nil {
+				return nil, err
+			}
+			object, err := ObjectFromDynamic(ctx, elementName, attributes, attribute.NestedObject)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, object)
+		}
+		list, diags := types.ListValue(attribute.NestedObject.Type(), objects)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to convert %s: %s", name, diags.Errors()[0].Detail())
+		}
+		return list, nil
+	default:
+		return nil, fmt.Errorf("%s has an unsupported type %s", name, attribute.GetType())
+	}
+}
+
+// Return the default value of the attribute (null if none).
+func DefaultFromSchema(ctx context.Context, attribute schema.Attribute) (attr.Value, error) {
+	switch attribute := attribute.(type) {
+	case schema.StringAttribute:
+		if attribute.Default != nil {
+			response := defaults.StringResponse{}
+			attribute.Default.DefaultString(ctx, defaults.StringRequest{}, &response)
+			return response.PlanValue, nil
+		}
+	case schema.BoolAttribute:
+		if attribute.Default != nil {
+			response := defaults.BoolResponse{}
+			attribute.Default.DefaultBool(ctx, defaults.BoolRequest{}, &response)
+			return response.PlanValue, nil
+		}
+	case schema.Int64Attribute:
+		if attribute.Default != nil {
+			response := defaults.Int64Response{}
+			attribute.Default.DefaultInt64(ctx, defaults.Int64Request{}, &response)
+			return response.PlanValue, nil
+		}
+	case schema.Int32Attribute:
+		if attribute.Default != nil {
+			response := defaults.Int32Response{}
+			attribute.Default.DefaultInt32(ctx, defaults.Int32Request{}, &response)
+			return response.PlanValue, nil
+		}
+	case schema.ListNestedAttribute:
+		return types.ListNull(attribute.NestedObject.Type()), nil
+	case schema.ListAttribute:
+		return types.ListNull(attribute.ElementType), nil
+	case schema.SingleNestedAttribute:
+		return types.ObjectNull(attribute.GetType().(types.ObjectType).AttrTypes), nil
+	}
+	return NullValue(attribute.GetType())
+}
+
+// Return the null value of a (simple) type.
+func NullValue(attrType attr.Type) (attr.Value, error) {
+	switch attrType := attrType.(type) {
+	case basetypes.StringTypable:
+		value, diags := attrType.ValueFromString(context.Background(), types.StringNull())
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to return a null value of type %s", attrType)
+		}
+		return value, nil
+	case basetypes.BoolType:
+		return types.BoolNull(), nil
+	case basetypes.Int64Type:
+		return types.Int64Null(), nil
+	case basetypes.Int32Type:
+		return types.Int32Null(), nil
+	}
+	return nil, fmt.Errorf("unable to return a null value of type %s", attrType)
+}
+
+// Convert a number to an integer of given type (Int64 or Int32).
+func IntegerFromDynamic(name string, value attr.Value, attrType attr.Type) (attr.Value, error) {
+	number, ok := value.(basetypes.NumberValue)
+	if !ok || !number.ValueBigFloat().IsInt() {
+		return nil, fmt.Errorf("%s must be an integer", name)
+	}
+	integer, accuracy := number.ValueBigFloat().Int64()
+	if attrType == types.Int32Type {
+		if accuracy != big.Exact || integer < math.MinInt32 || integer > math.MaxInt32 {
+			return nil, fmt.Errorf("%s must be a 32-bit integer", name)
+		}
+		return types.Int32Value(int32(integer)), nil
+	}
+	if accuracy != big.Exact {
+		return nil, fmt.Errorf("%s must be a 64-bit integer", name)
+	}
+	return types.Int64Value(integer), nil
+}
+
+// Return the elements of a tuple or a list.
+func ListElementsFromDynamic(name string, value attr.Value) ([]attr.Value, error) {
+	if dynamic, ok := value.(basetypes.DynamicValue); ok {
+		value = dynamic.UnderlyingValue()
+	}
+	switch list := value.(type) {
+	case basetypes.TupleValue:
+		return list.Elements(), nil
+	case basetypes.ListValue:
+		return list.Elements(), nil
+	}
+	return nil, fmt.Errorf("%s must be a list", name)
+}
+
+// Return the elements of an object or a map.
+func ElementsFromDynamic(name string, value attr.Value) (map[string]attr.Value, error) {
+	if dynamic, ok := value.(basetypes.DynamicValue); ok {
+		value = dynamic.UnderlyingValue()
+	}
+	switch value := value.(type) {
+	case basetypes.ObjectValue:
+		return maps.Clone(value.Attributes()), nil
+	case basetypes.MapValue:
+		return maps.Clone(value.Elements()), nil
+	}
+	return nil, fmt.Errorf("%s must be an object or a map", name)
+}
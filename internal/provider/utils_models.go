@@ -34,13 +34,14 @@ func StringOrNullValue(value string) types.String {
 }
 
 // Convert raw value to JSON encoded attribute.
+// Value may have been decoded from YAML (see YAMLToJSONCompatible).
 func JSONNormalizedFromAny(name string, value any) (jsontypes.Normalized, diag.Diagnostics) {
 	diags := diag.Diagnostics{}
 	if value == nil {
 		return jsontypes.NewNormalizedNull(), diags
 	}
 
-	valueJSON, err := json.Marshal(value)
+	valueJSON, err := json.Marshal(YAMLToJSONCompatible(value))
 	if err != nil {
 		diags.AddError(
 			"Client error",
@@ -63,3 +64,29 @@ func JSONNormalizedToAny(attribute jsontypes.Normalized) (any, diag.Diagnostics)
 	diags := attribute.Unmarshal(&value)
 	return value, diags
 }
+
+// Convert a value decoded from YAML to a value that can be JSON encoded.
+// Mappings are decoded with keys of any type (map[any]any), those are converted to strings.
+func YAMLToJSONCompatible(value any) any {
+	switch value := value.(type) {
+	case map[any]any:
+		result := make(map[string]any, len(value))
+		for key, item := range value {
+			result[fmt.Sprint(key)] = YAMLToJSONCompatible(item)
+		}
+		return result
+	case map[string]any:
+		result := make(map[string]any, len(value))
+		for key, item := range value {
+			result[key] = YAMLToJSONCompatible(item)
+		}
+		return result
+	case []any:
+		result := make([]any, len(value))
+		for index, item := range value {
+			result[index] = YAMLToJSONCompatible(item)
+		}
+		return result
+	}
+	return value
+}
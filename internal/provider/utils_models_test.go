@@ -0,0 +1,354 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Run go test ./internal/provider -run TestModelsRoundTrip -update to regenerate golden files.
+var updateGolden = flag.Bool("update", false, "Update the golden files of the models round-trip")
+
+// Directory of the API JSON fixtures (<name>.json) and their golden files (<name>.golden.json).
+const MODELS_FIXTURES_DIR = "testdata/models"
+
+// Changeset returned (as a response header) with the fixtures of the versioned instances.
+const MODELS_FIXTURES_CHANGESET = "0f4b7a0c6e2b5d1c9a8e7f6d5c4b3a2918273645"
+
+// Resources without any fixture (their API is not JSON, e.g. icons are uploaded as is).
+var MODELS_WITHOUT_FIXTURE = []string{"aria_icon"}
+
+// Load API JSON into a model (FromAPI), convert it back (ToAPI) and return the API model.
+type ModelRoundTrip func(ctx context.Context, data []byte) (any, diag.Diagnostics)
+
+// Round-trip of models with FromAPI(ctx, raw) diag.Diagnostics and
+// ToAPI(ctx) (raw, diag.Diagnostics).
+func RoundTripWithContext[M any, R any, PM interface {
+	*M
+	FromAPI(context.Context, R) diag.Diagnostics
+	ToAPI(context.Context) (R, diag.Diagnostics)
+}]() ModelRoundTrip {
+	return func(ctx context.Context, data []byte) (any, diag.Diagnostics) {
+		var raw R
+		diags := UnmarshalFixture(data, &raw)
+		if diags.HasError() {
+			return nil, diags
+		}
+		model := PM(new(M))
+		diags.Append(model.FromAPI(ctx, raw)...)
+		raw, someDiags := model.ToAPI(ctx)
+		diags.Append(someDiags...)
+		return raw, diags
+	}
+}
+
+// Round-trip of models with FromAPI(raw) and ToAPI() raw.
+func RoundTrip[M any, R any, PM interface {
+	*M
+	FromAPI(R)
+	ToAPI() R
+}]() ModelRoundTrip {
+	return func(ctx context.Context, data []byte) (any, diag.Diagnostics) {
+		var raw R
+		diags := UnmarshalFixture(data, &raw)
+		if diags.HasError() {
+			return nil, diags
+		}
+		model := PM(new(M))
+		model.FromAPI(raw)
+		return model.ToAPI(), diags
+	}
+}
+
+// Round-trips, key is the name of the fixture. Fixture's name starts with the resource's type
+// (without aria_ prefix), e.g. policy_approval for aria_policy.
+var MODELS_ROUND_TRIPS = map[string]ModelRoundTrip{
+	"abx_action":               RoundTripWithContext[ABXActionModel, ABXActionAPIModel](),
+	"abx_constant":             RoundTrip[ABXConstantModel, ABXConstantAPIModel](),
+	"abx_sensitive_constant":   RoundTrip[ABXSensitiveConstantModel, ABXSensitiveConstantAPIModel](),
+	"catalog_item_icon":        RoundTrip[CatalogItemIconModel, CatalogItemIconAPIModel](),
+	"catalog_source_actions":   RoundTripWithContext[CatalogSourceModel, CatalogSourceAPIModel](),
+	"catalog_source_workflows": RoundTripWithContext[CatalogSourceModel, CatalogSourceAPIModel](),
+	"cloud_account_vsphere": RoundTripWithContext[
+		CloudAccountVSphereModel, CloudAccountVSphereAPIModel,
+	](),
+	"cloud_template_v1":          RoundTripWithContext[CloudTemplateV1Model, CloudTemplateV1APIModel](),
+	"cloud_template_v1_content":  CloudTemplateV1ContentRoundTrip,
+	"cloud_template_version":     CloudTemplateVersionRoundTrip,
+	"cloud_zone":                 RoundTripWithContext[CloudZoneModel, CloudZoneAPIModel](),
+	"custom_form":                RoundTrip[CustomFormModel, CustomFormAPIModel](),
+	"custom_naming":              CustomNamingRoundTrip,
+	"custom_resource":            RoundTripWithContext[CustomResourceModel, CustomResourceAPIModel](),
+	"deployment_catalog_item":    DeploymentRoundTrip,
+	"deployment_cloud_template":  DeploymentRoundTrip,
+	"flavor_profile":             RoundTrip[FlavorProfileModel, FlavorProfileAPIModel](),
+	"image_profile":              RoundTrip[ImageProfileModel, ImageProfileAPIModel](),
+	"orchestrator_action":        RoundTripWithContext[OrchestratorActionModel, OrchestratorActionAPIModel](),
+	"orchestrator_category":      RoundTrip[OrchestratorCategoryModel, OrchestratorCategoryAPIModel](),
+	"orchestrator_configuration": OrchestratorConfigurationRoundTrip,
+	"orchestrator_environment":   OrchestratorEnvironmentRoundTrip,
+	"orchestrator_environment_repository": RoundTrip[
+		OrchestratorEnvironmentRepositoryModel, OrchestratorEnvironmentRepositoryAPIModel,
+	](),
+	"orchestrator_task":     RoundTripWithContext[OrchestratorTaskModel, OrchestratorTaskAPIModel](),
+	"orchestrator_workflow": OrchestratorWorkflowRoundTrip,
+	"policy_approval":       RoundTripWithContext[PolicyModel, PolicyAPIModel](),
+	"policy_day2_action":    RoundTripWithContext[PolicyModel, PolicyAPIModel](),
+	"project":               RoundTripWithContext[ProjectModel, ProjectAPIModel](),
+	"project_membership":    ProjectMembershipRoundTrip,
+	"property_group":        RoundTripWithContext[PropertyGroupModel, PropertyGroupAPIModel](),
+	"resource_action":       RoundTripWithContext[ResourceActionModel, ResourceActionAPIModel](),
+	"subscription":          RoundTripWithContext[SubscriptionModel, SubscriptionAPIModel](),
+	"tag":                   RoundTrip[TagModel, TagAPIModel](),
+}
+
+// Custom naming's templates are converted using the state (none here).
+func CustomNamingRoundTrip(ctx context.Context, data []byte) (any, diag.Diagnostics) {
+	var raw CustomNamingAPIModel
+	diags := UnmarshalFixture(data, &raw)
+	if diags.HasError() {
+		return nil, diags
+	}
+	naming := CustomNamingModel{}
+	diags.Append(naming.FromAPI(ctx, raw)...)
+	raw, someDiags := naming.ToAPI(ctx, CustomNamingModel{})
+	diags.Append(someDiags...)
+	return raw, diags
+}
+
+// Cloud template's content is managed as is (raw content mode), its content is sent unchanged.
+func CloudTemplateV1ContentRoundTrip(ctx context.Context, data []byte) (any, diag.Diagnostics) {
+	var raw CloudTemplateV1APIModel
+	diags := UnmarshalFixture(data, &raw)
+	if diags.HasError() {
+		return nil, diags
+	}
+	template := CloudTemplateV1Model{Content: NewYAMLNormalizedValue("")}
+	diags.Append(template.FromAPI(ctx, raw)...)
+	raw, someDiags := template.ToAPI(ctx)
+	diags.Append(someDiags...)
+	return raw, diags
+}
+
+// Version is read with the content of the cloud template but only the request creating it is sent.
+func CloudTemplateVersionRoundTrip(ctx context.Context, data []byte) (any, diag.Diagnostics) {
+	var raw CloudTemplateVersionAPIModel
+	diags := UnmarshalFixture(data, &raw)
+	if diags.HasError() {
+		return nil, diags
+	}
+	version := CloudTemplateVersionModel{}
+	diags.Append(version.FromAPI(raw)...)
+	return version.ToAPI(), diags
+}
+
+// Deployment is read with its resources but only the request of the catalog item (or of the cloud
+// template) is sent, with no inputs (they are not imported).
+func DeploymentRoundTrip(ctx context.Context, data []byte) (any, diag.Diagnostics) {
+	var raw DeploymentAPIModel
+	diags := UnmarshalFixture(data, &raw)
+	if diags.HasError() {
+		return nil, diags
+	}
+	deployment := DeploymentModel{}
+	diags.Append(deployment.FromAPI(ctx, raw)...)
+	if deployment.IsCatalogItem() {
+		request, someDiags := deployment.ToCatalogItemRequestAPI()
+		diags.Append(someDiags...)
+		return request, diags
+	}
+	request, someDiags := deployment.ToCloudTemplateRequestAPI()
+	diags.Append(someDiags...)
+	return request, diags
+}
+
+// Memberships are read from the project, each principal of the project is looked up then granted
+// its role again (the changes are merged).
+func ProjectMembershipRoundTrip(ctx context.Context, data []byte) (any, diag.Diagnostics) {
+	var raw ProjectAPIModel
+	diags := UnmarshalFixture(data, &raw)
+	if diags.HasError() || raw.ProjectPrincipalsAPIModel == nil {
+		return nil, diags
+	}
+	changes := ProjectPrincipalsChangesAPIModel{
+		Modify: []ProjectMembershipAPIModel{},
+		Remove: []ProjectPrincipalAPIModel{},
+	}
+	for _, principal := range raw.ToMemberships() {
+		membership := ProjectMembershipResourceModel{}
+		id := strings.Join([]string{
+			raw.Id, principal.Type.ValueString(), principal.Email.ValueString(),
+		}, "/")
+		if err := membership.ParseId(id); err != nil {
+			diags.AddError("Invalid fixture", err.Error())
+		} else if !membership.FromAPI(*raw.ProjectPrincipalsAPIModel) {
+			diags.AddError("Invalid fixture", fmt.Sprintf("%s not found", membership.String()))
+		}
+		changes.Modify = append(changes.Modify, membership.ToAPI().Modify...)
+	}
+	return changes, diags
+}
+
+// Configuration's version identifier is returned as a response header.
+func OrchestratorConfigurationRoundTrip(ctx context.Context, data []byte) (any, diag.Diagnostics) {
+	var raw OrchestratorConfigurationAPIModel
+	diags := UnmarshalFixture(data, &raw)
+	if diags.HasError() {
+		return nil, diags
+	}
+	configuration := OrchestratorConfigurationModel{}
+	diags.Append(configuration.FromAPI(ctx, raw, NewFixtureResponse())...)
+	raw, someDiags := configuration.ToAPI(ctx)
+	diags.Append(someDiags...)
+	return raw, diags
+}
+
+// Environment's version identifier is returned as a response header.
+func OrchestratorEnvironmentRoundTrip(ctx context.Context, data []byte) (any, diag.Diagnostics) {
+	var raw OrchestratorEnvironmentAPIModel
+	diags := UnmarshalFixture(data, &raw)
+	if diags.HasError() {
+		return nil, diags
+	}
+	environment := OrchestratorEnvironmentModel{}
+	diags.Append(environment.FromAPI(ctx, raw, NewFixtureResponse())...)
+	raw, someDiags := environment.ToAPI(ctx)
+	diags.Append(someDiags...)
+	return raw, diags
+}
+
+// Workflow is managed using many API endpoints, its content is the main one.
+func OrchestratorWorkflowRoundTrip(ctx context.Context, data []byte) (any, diag.Diagnostics) {
+	var raw OrchestratorWorkflowContentAPIModel
+	diags := UnmarshalFixture(data, &raw)
+	if diags.HasError() {
+		return nil, diags
+	}
+	workflow := OrchestratorWorkflowModel{}
+	diags.Append(workflow.FromContentAPI(ctx, raw, NewFixtureResponse())...)
+	raw, someDiags := workflow.ToContentAPI(ctx)
+	diags.Append(someDiags...)
+	return raw, diags
+}
+
+// Return a response with the headers returned with the fixtures.
+func NewFixtureResponse() *resty.Response {
+	return &resty.Response{RawResponse: &http.Response{
+		Header: http.Header{"X-Vro-Changeset-Sha": []string{MODELS_FIXTURES_CHANGESET}},
+	}}
+}
+
+func UnmarshalFixture(data []byte, raw any) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if err := json.Unmarshal(data, raw); err != nil {
+		diags.AddError("Invalid fixture", err.Error())
+	}
+	return diags
+}
+
+func MarshalFixture(t *testing.T, raw any) []byte {
+	data := bytes.Buffer{}
+	encoder := json.NewEncoder(&data)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(raw); err != nil {
+		t.Fatalf("Unable to marshal API model, got error: %s", err)
+	}
+	return data.Bytes()
+}
+
+// Return the fixture with its top-level attributes overridden by the ones of output.
+func OverlayFixture(t *testing.T, fixture []byte, output []byte) []byte {
+	merged := map[string]any{}
+	overrides := map[string]any{}
+	for _, data := range []struct {
+		content []byte
+		values  *map[string]any
+	}{{fixture, &merged}, {output, &overrides}} {
+		decoder := json.NewDecoder(bytes.NewReader(data.content))
+		decoder.UseNumber()
+		if err := decoder.Decode(data.values); err != nil {
+			t.Fatalf("Unable to unmarshal API model, got error: %s", err)
+		}
+	}
+	maps.Copy(merged, overrides)
+	return MarshalFixture(t, merged)
+}
+
+func TestModelsRoundTrip(t *testing.T) {
+	for name, roundTrip := range MODELS_ROUND_TRIPS {
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join(MODELS_FIXTURES_DIR, name+".json"))
+			if err != nil {
+				t.Fatalf("Unable to read fixture, got error: %s", err)
+			}
+
+			raw, diags := roundTrip(t.Context(), data)
+			CheckDiagnostics(t, diags, "", "")
+			output := MarshalFixture(t, raw)
+
+			// Round-trip must be stable (sending back the data returned by the API, with the
+			// computed attributes, e.g. timestamps, as returned by the API)
+			rawAgain, diags := roundTrip(t.Context(), OverlayFixture(t, data, output))
+			CheckDiagnostics(t, diags, "", "")
+			CheckEqual(t, string(MarshalFixture(t, rawAgain)), string(output))
+
+			golden := filepath.Join(MODELS_FIXTURES_DIR, name+".golden.json")
+			if *updateGolden {
+				if err := os.WriteFile(golden, output, 0o644); err != nil {
+					t.Fatalf("Unable to write golden file, got error: %s", err)
+				}
+			}
+			expected, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("Unable to read golden file (run with -update), got error: %s", err)
+			}
+			CheckEqual(t, string(output), string(expected))
+		})
+	}
+}
+
+// Every fixture is tested and every resource has at least one fixture.
+func TestModelsFixtures(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join(MODELS_FIXTURES_DIR, "*.json"))
+	if err != nil {
+		t.Fatalf("Unable to list fixtures, got error: %s", err)
+	}
+	for _, fixture := range fixtures {
+		name := strings.TrimSuffix(strings.TrimSuffix(filepath.Base(fixture), ".json"), ".golden")
+		if _, found := MODELS_ROUND_TRIPS[name]; !found {
+			t.Errorf("Fixture %s is not declared in MODELS_ROUND_TRIPS", fixture)
+		}
+	}
+
+	ctx := t.Context()
+	for _, newResource := range New("test")().Resources(ctx) {
+		response := resource.MetadataResponse{}
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "aria"}, &response)
+		if slices.Contains(MODELS_WITHOUT_FIXTURE, response.TypeName) {
+			continue
+		}
+		prefix := strings.TrimPrefix(response.TypeName, "aria_")
+		if !slices.ContainsFunc(slices.Collect(maps.Keys(MODELS_ROUND_TRIPS)), func(name string) bool {
+			return name == prefix || strings.HasPrefix(name, prefix+"_")
+		}) {
+			t.Errorf("Resource %s has no fixture in %s", response.TypeName, MODELS_FIXTURES_DIR)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // TODO How to deduplicate code without introducing more loc?
@@ -60,3 +61,17 @@ func GetResourceClient(
 
 	return client
 }
+
+// Return the value of an attribute (at the root) of a raw plan, state or configuration (a null
+// value if the attribute or its parent is missing, e.g. the state when creating).
+func GetRawAttribute(raw tftypes.Value, name string) tftypes.Value {
+	attributePath := tftypes.NewAttributePath().WithAttributeName(name)
+	value, _, err := tftypes.WalkAttributePath(raw, attributePath)
+	if err != nil {
+		return tftypes.Value{}
+	}
+	if value, ok := value.(tftypes.Value); ok {
+		return value
+	}
+	return tftypes.Value{}
+}
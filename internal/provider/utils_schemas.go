@@ -4,6 +4,11 @@
 package provider
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -130,3 +135,67 @@ func RequiredProjectIdSchema() schema.StringAttribute {
 		Required:            true,
 	}
 }
+
+// List (data sources)
+
+func ListFilterSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "[OData](https://www.odata.org/documentation/odata-version-2-0/" +
+			"uri-conventions/) filter expression sent to the API (e.g. `name eq 'foo'`)",
+		Optional: true,
+	}
+}
+
+func ListSearchSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Keep only the instances whose name contains this text " +
+			"(case insensitive)",
+		Optional: true,
+	}
+}
+
+// Timeouts
+
+func TimeoutsSchema(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true, Delete: true})
+}
+
+// Plan modifiers
+
+// Use the prior state value of a computed map unless the attribute it is derived from (at given
+// path) is changing (e.g. the identifiers of the regions of a cloud account).
+func UseStateForUnknownUnlessChanged(source path.Path) planmodifier.Map {
+	return useStateForUnknownUnlessChangedModifier{source: source}
+}
+
+type useStateForUnknownUnlessChangedModifier struct {
+	source path.Path
+}
+
+func (self useStateForUnknownUnlessChangedModifier) Description(ctx context.Context) string {
+	return "Once set, the value is kept unless " + self.source.String() + " is changing."
+}
+
+func (self useStateForUnknownUnlessChangedModifier) MarkdownDescription(
+	ctx context.Context,
+) string {
+	return self.Description(ctx)
+}
+
+func (self useStateForUnknownUnlessChangedModifier) PlanModifyMap(
+	ctx context.Context,
+	req planmodifier.MapRequest,
+	resp *planmodifier.MapResponse,
+) {
+	// Nothing to do on create, destroy or if the value is known
+	if req.StateValue.IsNull() || req.Plan.Raw.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var planSource, stateSource attr.Value
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, self.source, &planSource)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, self.source, &stateSource)...)
+	if !resp.Diagnostics.HasError() && planSource.Equal(stateSource) {
+		resp.PlanValue = req.StateValue
+	}
+}
@@ -5,8 +5,12 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -75,3 +79,40 @@ func (self *RWMutexKV) get(key string) *sync.RWMutex {
 	}
 	return mutex
 }
+
+// Wait for given duration, unless the context is done (operation cancelled or timed out) before.
+// Return the context error in the latter case.
+func Sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Return the interval between polls, fitting POLL_COUNT polls before the operation's deadline.
+// Interval is bounded between 1 second and given maximum (which is used if there is no deadline).
+func GetPollInterval(ctx context.Context, maxInterval time.Duration) time.Duration {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return maxInterval
+	}
+	return min(max(time.Until(deadline)/POLL_COUNT, time.Second), maxInterval)
+}
+
+// Return an error diagnostic explaining why action (e.g. "waiting for X to be deleted") has been
+// interrupted, either the operation timed out or has been cancelled.
+func InterruptedDiagnostics(err error, action string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if errors.Is(err, context.DeadlineExceeded) {
+		diags.AddError(
+			"Operation timed out",
+			fmt.Sprintf("Timeout while %s (timeouts may be increased if necessary).", action))
+	} else {
+		diags.AddError("Operation interrupted", fmt.Sprintf("Stopped %s: %s", action, err))
+	}
+	return diags
+}
@@ -0,0 +1,46 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestGetPollInterval(t *testing.T) {
+	CheckEqual(t, GetPollInterval(t.Context(), 30*time.Second), 30*time.Second)
+
+	// Interval fits POLL_COUNT polls before the deadline and is bounded
+	cases := []struct {
+		name     string
+		timeout  time.Duration
+		expected time.Duration
+	}{
+		{"Short", 10 * time.Second, time.Second},
+		{"Medium", 5 * time.Minute, 10 * time.Second},
+		{"Long", time.Hour, 30 * time.Second},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(t.Context(), tc.timeout)
+			defer cancel()
+			result := GetPollInterval(ctx, 30*time.Second)
+			if result > tc.expected || result < tc.expected-time.Second {
+				t.Errorf("Expected interval of about %s, got %s", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestInterruptedDiagnostics(t *testing.T) {
+	diags := InterruptedDiagnostics(context.DeadlineExceeded, "waiting for X")
+	CheckEqual(t, diags[0].Summary(), "Operation timed out")
+	CheckDiagnostics(
+		t, diags, "", "Timeout while waiting for X (timeouts may be increased if necessary).")
+
+	diags = InterruptedDiagnostics(context.Canceled, "waiting for X")
+	CheckEqual(t, diags[0].Summary(), "Operation interrupted")
+	CheckDiagnostics(t, diags, "", "Stopped waiting for X: context canceled")
+}
@@ -8,7 +8,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func CheckDiagnostics(
@@ -74,3 +77,31 @@ func CheckDeepEqual(t *testing.T, actual interface{}, expected interface{}) {
 		t.Errorf("Result was incorrect,\nactual  : %s\nexpected: %s", actual, expected)
 	}
 }
+
+// Run a function returning a string, check its error (if any) and return its result.
+func RunFunction(
+	t *testing.T,
+	fn function.Function,
+	errorMessage string,
+	arguments ...attr.Value,
+) string {
+	req := function.RunRequest{Arguments: function.NewArgumentsData(arguments)}
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	fn.Run(t.Context(), req, &resp)
+
+	if resp.Error != nil {
+		if errorMessage == "" || !strings.Contains(resp.Error.Text, errorMessage) {
+			t.Errorf("Message \"%s\" not found in error \"%s\".", errorMessage, resp.Error.Text)
+		}
+		return ""
+	}
+	if errorMessage != "" {
+		t.Errorf("Message \"%s\" not found, there are no errors.", errorMessage)
+	}
+
+	result, ok := resp.Result.Value().(types.String)
+	if !ok {
+		t.Fatalf("Function must return a string, got %s", resp.Result.Value())
+	}
+	return result.ValueString()
+}
@@ -0,0 +1,136 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v2"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ basetypes.StringTypable = YAMLNormalizedType{}
+var _ basetypes.StringValuableWithSemanticEquals = YAMLNormalized{}
+var _ xattr.ValidateableAttribute = YAMLNormalized{}
+
+// YAMLNormalizedType is a string type holding a YAML document, documents are compared by their
+// content (formatting, comments, quoting style and order of the keys are not significant).
+type YAMLNormalizedType struct {
+	basetypes.StringType
+}
+
+func (self YAMLNormalizedType) String() string {
+	return "YAMLNormalizedType"
+}
+
+func (self YAMLNormalizedType) ValueType(ctx context.Context) attr.Value {
+	return YAMLNormalized{}
+}
+
+func (self YAMLNormalizedType) Equal(other attr.Type) bool {
+	otherType, ok := other.(YAMLNormalizedType)
+	return ok && self.StringType.Equal(otherType.StringType)
+}
+
+func (self YAMLNormalizedType) ValueFromString(
+	ctx context.Context,
+	in basetypes.StringValue,
+) (basetypes.StringValuable, diag.Diagnostics) {
+	return YAMLNormalized{StringValue: in}, diag.Diagnostics{}
+}
+
+func (self YAMLNormalizedType) ValueFromTerraform(
+	ctx context.Context,
+	in tftypes.Value,
+) (attr.Value, error) {
+	attrValue, err := self.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return YAMLNormalized{StringValue: stringValue}, nil
+}
+
+// YAMLNormalized is a string value holding a YAML document.
+type YAMLNormalized struct {
+	basetypes.StringValue
+}
+
+func NewYAMLNormalizedNull() YAMLNormalized {
+	return YAMLNormalized{StringValue: basetypes.NewStringNull()}
+}
+
+func NewYAMLNormalizedValue(value string) YAMLNormalized {
+	return YAMLNormalized{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (self YAMLNormalized) Type(ctx context.Context) attr.Type {
+	return YAMLNormalizedType{}
+}
+
+func (self YAMLNormalized) Equal(other attr.Value) bool {
+	otherValue, ok := other.(YAMLNormalized)
+	return ok && self.StringValue.Equal(otherValue.StringValue)
+}
+
+// Return true if both documents have the same content.
+func (self YAMLNormalized) StringSemanticEquals(
+	ctx context.Context,
+	newValuable basetypes.StringValuable,
+) (bool, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	newValue, ok := newValuable.(YAMLNormalized)
+	if !ok {
+		diags.AddError(
+			"Semantic equality check error",
+			fmt.Sprintf("Expected value type %T but got %T", self, newValuable))
+		return false, diags
+	}
+
+	value, err := YAMLDecode(self.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	otherValue, err := YAMLDecode(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	return reflect.DeepEqual(value, otherValue), diags
+}
+
+// Report an invalid YAML document when validating the configuration.
+func (self YAMLNormalized) ValidateAttribute(
+	ctx context.Context,
+	req xattr.ValidateAttributeRequest,
+	resp *xattr.ValidateAttributeResponse,
+) {
+	if self.IsNull() || self.IsUnknown() {
+		return
+	}
+	if _, err := YAMLDecode(self.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid YAML String Value",
+			fmt.Sprintf("Unable to YAML decode the value, got error: %s", err))
+	}
+}
+
+// Decode a YAML document, mappings are converted to map[string]any (see YAMLToJSONCompatible).
+func YAMLDecode(content string) (any, error) {
+	var value any
+	if err := yaml.Unmarshal([]byte(content), &value); err != nil {
+		return nil, err
+	}
+	return YAMLToJSONCompatible(value), nil
+}
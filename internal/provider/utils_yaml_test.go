@@ -0,0 +1,92 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestYAMLNormalizedSemanticEquals(t *testing.T) {
+	content := NewYAMLNormalizedValue(`formatVersion: 1
+inputs:
+  flavor:
+    type: string
+resources:
+  vm:
+    type: Cloud.vSphere.Machine
+    properties:
+      flavor: ${input.flavor}
+      disks: [1, 2]
+`)
+	cases := []struct {
+		name     string
+		other    string
+		expected bool
+	}{
+		{
+			name: "same content, other formatting",
+			other: `# Some comment
+formatVersion: 1
+resources:
+  vm:
+    properties:
+      disks:
+        - 1
+        - 2
+      flavor: '${input.flavor}'
+    type: "Cloud.vSphere.Machine"
+inputs: {flavor: {type: string}}
+`,
+			expected: true,
+		},
+		{
+			name: "other content",
+			other: `formatVersion: 1
+inputs:
+  flavor:
+    type: string
+resources:
+  vm:
+    type: Cloud.vSphere.Machine
+    properties:
+      flavor: ${input.flavor}
+      disks: [2, 1]
+`,
+			expected: false,
+		},
+		{
+			name:     "invalid YAML",
+			other:    "formatVersion: [",
+			expected: false,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			equal, diags := content.StringSemanticEquals(
+				t.Context(), NewYAMLNormalizedValue(tc.other))
+			CheckDiagnostics(t, diags, "", "")
+			CheckEqual(t, equal, tc.expected)
+		})
+	}
+}
+
+func TestYAMLNormalizedValidateAttribute(t *testing.T) {
+	for _, content := range []YAMLNormalized{
+		NewYAMLNormalizedNull(),
+		NewYAMLNormalizedValue("formatVersion: 1\n"),
+	} {
+		resp := xattr.ValidateAttributeResponse{}
+		content.ValidateAttribute(
+			t.Context(), xattr.ValidateAttributeRequest{Path: path.Root("content")}, &resp)
+		CheckDiagnostics(t, resp.Diagnostics, "", "")
+	}
+
+	resp := xattr.ValidateAttributeResponse{}
+	NewYAMLNormalizedValue("formatVersion: [").ValidateAttribute(
+		t.Context(), xattr.ValidateAttributeRequest{Path: path.Root("content")}, &resp)
+	CheckDiagnostics(t, resp.Diagnostics, "", "Unable to YAML decode the value")
+}
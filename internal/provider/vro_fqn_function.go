@@ -0,0 +1,71 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &VROFQNFunction{}
+
+func NewVROFQNFunction() function.Function {
+	return &VROFQNFunction{}
+}
+
+// VROFQNFunction defines the function implementation.
+type VROFQNFunction struct{}
+
+func (self *VROFQNFunction) Metadata(
+	ctx context.Context,
+	req function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "vro_fqn"
+}
+
+func (self *VROFQNFunction) Definition(
+	ctx context.Context,
+	req function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Return the fully qualified name of an Orchestrator action",
+		MarkdownDescription: "Return the fully qualified name (aka FQN) of an Orchestrator action " +
+			"(e.g. `ch.ocsin.core/getVRAHost`), as expected by `aria_orchestrator_action`'s `fqn`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "module",
+				MarkdownDescription: "Where the action is stored (e.g. `ch.ocsin.core`)",
+			},
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Action name (e.g. `getVRAHost`)",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (self *VROFQNFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var module, name string
+	resp.Error = req.Arguments.Get(ctx, &module, &name)
+	if resp.Error != nil {
+		return
+	}
+
+	fqn, err := OrchestratorActionFQN(module, name)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, fqn)
+}
@@ -0,0 +1,30 @@
+// Copyright (c) State of Geneva (Switzerland)
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVROFQNFunction(t *testing.T) {
+	cases := []struct {
+		module       string
+		name         string
+		fqn          string
+		errorMessage string
+	}{
+		{module: "ch.ocsin.core", name: "getVRAHost", fqn: "ch.ocsin.core/getVRAHost"},
+		{module: "", name: "getVRAHost", errorMessage: "module must not be empty"},
+		{module: "ch.ocsin.core", name: "", errorMessage: "name must not be empty"},
+		{module: "ch/ocsin", name: "getVRAHost", errorMessage: "module ch/ocsin must not contain"},
+	}
+	for _, c := range cases {
+		fqn := RunFunction(
+			t, NewVROFQNFunction(), c.errorMessage,
+			types.StringValue(c.module), types.StringValue(c.name))
+		CheckEqual(t, fqn, c.fqn)
+	}
+}